@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-blogpost-struct
+type BlogPost struct {
+	Title       string
+	Author      string
+	WordCount   int `bson:"word_count"`
+	LastUpdated time.Time
+	Tags        []string
+}
+
+// end-blogpost-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("sample_training").Collection("posts").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("sample_training").Collection("posts")
+	docs := []interface{}{
+		BlogPost{Title: "Annuals vs. Perennials?", Author: "Sam Lee", WordCount: 682, LastUpdated: time.Date(2023, 3, 14, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "gardening", "flower"}},
+		BlogPost{Title: "Planting Bulbs in Autumn", Author: "Priya Raman", WordCount: 915, LastUpdated: time.Date(2023, 9, 2, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "flower", "autumn"}},
+		BlogPost{Title: "Companion Planting for Tomatoes", Author: "Sam Lee", WordCount: 1204, LastUpdated: time.Date(2023, 5, 21, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "vegetables", "soil"}},
+		BlogPost{Title: "Winter Care for Houseplants", Author: "Alex Kim", WordCount: 540, LastUpdated: time.Date(2023, 12, 8, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "houseplants"}},
+		BlogPost{Title: "Building a Compost Bin", Author: "Priya Raman", WordCount: 777, LastUpdated: time.Date(2023, 6, 30, 0, 0, 0, 0, time.Local), Tags: []string{"soil", "diy", "gardening"}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nAdd to Set:\n")
+	{
+		// begin add to set
+		// $addToSet appends only the values that aren't already in the
+		// array. A $push with the same values would add a second
+		// "gardening" tag.
+		filter := bson.D{{"title", "Companion Planting for Tomatoes"}}
+		update := bson.D{{"$addToSet", bson.D{{"tags", bson.D{
+			{"$each", bson.A{"gardening", "seasons"}},
+		}}}}}
+
+		var before BlogPost
+		if err := coll.FindOne(context.TODO(), filter).Decode(&before); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Before: %v\n", before.Tags)
+
+		if _, err := coll.UpdateOne(context.TODO(), filter, update); err != nil {
+			panic(err)
+		}
+
+		var after BlogPost
+		if err := coll.FindOne(context.TODO(), filter).Decode(&after); err != nil {
+			panic(err)
+		}
+		fmt.Printf("After: %v\n", after.Tags)
+		// end add to set
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-blogpost-struct
+type BlogPost struct {
+	Title       string
+	Author      string
+	WordCount   int `bson:"word_count"`
+	LastUpdated time.Time
+	Tags        []string
+}
+
+// end-blogpost-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+	client.Database("sample_training").Collection("posts").Drop(context.TODO())
+
+	// begin insert docs
+	reviewsColl := client.Database("tea").Collection("reviews")
+	reviews := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	if _, err := reviewsColl.InsertMany(context.TODO(), reviews); err != nil {
+		panic(err)
+	}
+
+	postsColl := client.Database("sample_training").Collection("posts")
+	posts := []interface{}{
+		BlogPost{Title: "Annuals vs. Perennials?", Author: "Sam Lee", WordCount: 682, LastUpdated: time.Date(2023, 3, 14, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "gardening", "flower"}},
+		BlogPost{Title: "Planting Bulbs in Autumn", Author: "Priya Raman", WordCount: 915, LastUpdated: time.Date(2023, 9, 2, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "flower", "autumn"}},
+		BlogPost{Title: "Companion Planting for Tomatoes", Author: "Sam Lee", WordCount: 1204, LastUpdated: time.Date(2023, 5, 21, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "vegetables", "soil"}},
+		BlogPost{Title: "Winter Care for Houseplants", Author: "Alex Kim", WordCount: 540, LastUpdated: time.Date(2023, 12, 8, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "houseplants"}},
+		BlogPost{Title: "Building a Compost Bin", Author: "Priya Raman", WordCount: 777, LastUpdated: time.Date(2023, 6, 30, 0, 0, 0, 0, time.Local), Tags: []string{"soil", "diy", "gardening"}},
+	}
+
+	result, err := postsColl.InsertMany(context.TODO(), posts)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nIn:\n")
+	{
+		// begin in
+		// Matches reviews whose item is any of the listed values
+		filter := bson.D{{"item", bson.D{{"$in", bson.A{"Masala", "Sencha"}}}}}
+
+		cursor, err := reviewsColl.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end in
+	}
+
+	fmt.Println("\nNot In:\n")
+	{
+		// begin nin
+		// Matches reviews whose item is none of the listed values
+		filter := bson.D{{"item", bson.D{{"$nin", bson.A{"Masala", "Sencha"}}}}}
+
+		cursor, err := reviewsColl.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end nin
+	}
+
+	fmt.Println("\nAll:\n")
+	{
+		// begin all
+		// Matches posts whose tags array contains every listed value, in
+		// any order and alongside any other tags
+		filter := bson.D{{"tags", bson.D{{"$all", bson.A{"gardening", "flower"}}}}}
+
+		cursor, err := postsColl.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []BlogPost
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%s: %v\n", result.Title, result.Tags)
+		}
+		// end all
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nUnordered Bulk Write:\n")
+	{
+		// begin unordered
+		models := []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}),
+			mongo.NewUpdateOneModel().SetFilter(bson.D{{"item", "Hibiscus"}}).
+				SetUpdate(bson.D{{"$set", bson.D{{"rating", 6}}}}),
+			mongo.NewDeleteOneModel().SetFilter(bson.D{{"item", "Sencha"}}),
+		}
+		opts := options.BulkWrite().SetOrdered(false)
+
+		results, err := coll.BulkWrite(context.TODO(), models, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents inserted: %d\n", results.InsertedCount)
+		fmt.Printf("Number of documents modified: %d\n", results.ModifiedCount)
+		fmt.Printf("Number of documents deleted: %d\n", results.DeletedCount)
+		// end unordered
+	}
+
+	fmt.Println("\nOrdered Bulk Write:\n")
+	{
+		// begin ordered
+		// The second model reuses the _id of an existing document, so it
+		// fails with a duplicate key error
+		models := []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Earl Grey", Rating: 8, DateOrdered: time.Date(2010, 1, 9, 0, 0, 0, 0, time.Local)}),
+			mongo.NewInsertOneModel().SetDocument(bson.D{{"_id", result.InsertedIDs[0]}, {"item", "Masala"}, {"rating", 5}}),
+			mongo.NewDeleteOneModel().SetFilter(bson.D{{"item", "Oolong"}}),
+		}
+		opts := options.BulkWrite().SetOrdered(true)
+
+		_, err := coll.BulkWrite(context.TODO(), models, opts)
+
+		var bwe mongo.BulkWriteException
+		if errors.As(err, &bwe) {
+			for _, writeErr := range bwe.WriteErrors {
+				fmt.Printf("Operation at index %d failed: %s\n", writeErr.Index, writeErr.Message)
+			}
+			fmt.Println("An ordered bulk write stops at the first error, so the operations after it did not run")
+		} else if err != nil {
+			panic(err)
+		}
+		// end ordered
+
+		count, err := coll.CountDocuments(context.TODO(), bson.D{{"item", "Oolong"}})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Number of Oolong reviews remaining: %d\n", count)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin unique index
+	// Allow at most one review per order date
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{"date_ordered", 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := coll.Indexes().CreateOne(context.TODO(), indexModel); err != nil {
+		panic(err)
+	}
+	// end unique index
+
+	fmt.Println("\nBulk Write with Partial Failures:\n")
+	{
+		// begin bulk write errors
+		// The models at index 1 and 3 reuse the order date of an existing
+		// review, so they violate the unique index
+		models := []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}),
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Earl Grey", Rating: 8, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)}),
+			mongo.NewUpdateOneModel().SetFilter(bson.D{{"item", "Hibiscus"}}).
+				SetUpdate(bson.D{{"$set", bson.D{{"rating", 6}}}}),
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Jasmine", Rating: 9, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)}),
+			mongo.NewInsertOneModel().SetDocument(Review{Item: "Rooibos", Rating: 6, DateOrdered: time.Date(2010, 1, 7, 0, 0, 0, 0, time.Local)}),
+		}
+
+		// An unordered bulk write keeps going after an error, so every
+		// model that doesn't fail is still applied
+		opts := options.BulkWrite().SetOrdered(false)
+		_, err := coll.BulkWrite(context.TODO(), models, opts)
+
+		var bwe mongo.BulkWriteException
+		if errors.As(err, &bwe) {
+			for _, writeErr := range bwe.WriteErrors {
+				fmt.Printf("Operation at index %d failed with code %d: %s\n", writeErr.Index, writeErr.Code, writeErr.Message)
+			}
+		} else if err != nil {
+			panic(err)
+		}
+		// end bulk write errors
+	}
+
+	fmt.Println("\nConfirm Successful Operations:\n")
+	{
+		// begin confirm writes
+		filter := bson.D{{"item", bson.D{{"$in", bson.A{"Oolong", "Earl Grey", "Hibiscus", "Jasmine", "Rooibos"}}}}}
+		opts := options.Find().SetSort(bson.D{{"date_ordered", 1}})
+
+		cursor, err := coll.Find(context.TODO(), filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+
+		// The Oolong and Rooibos reviews were inserted and the Hibiscus
+		// review was updated. There are no Earl Grey or Jasmine reviews.
+		for _, result := range results {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+		// end confirm writes
+	}
+}
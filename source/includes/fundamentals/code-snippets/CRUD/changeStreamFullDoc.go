@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-event-struct
+type ChangeEvent struct {
+	OperationType string `bson:"operationType"`
+	FullDocument  Review `bson:"fullDocument"`
+}
+
+// end-event-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nWatch with Full Document Lookup:\n")
+	{
+		// begin full document
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		pipeline := mongo.Pipeline{bson.D{{"$match", bson.D{{"operationType", "update"}}}}}
+		opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+
+		cs, err := coll.Watch(ctx, pipeline, opts)
+		if err != nil {
+			panic(err)
+		}
+		defer cs.Close(context.TODO())
+
+		filter := bson.D{{"item", "Hibiscus"}}
+		update := bson.D{{"$set", bson.D{{"rating", 6}}}}
+		if _, err := coll.UpdateOne(context.TODO(), filter, update); err != nil {
+			panic(err)
+		}
+
+		// Without the UpdateLookup option, the update event describes
+		// only the changed fields. With it, FullDocument contains the
+		// entire document as it exists after the update.
+		if cs.Next(ctx) {
+			var event ChangeEvent
+			if err := cs.Decode(&event); err != nil {
+				panic(err)
+			}
+			res, _ := json.Marshal(event.FullDocument)
+			fmt.Printf("%s: %s\n", event.OperationType, res)
+		}
+		if err := cs.Err(); err != nil {
+			panic(err)
+		}
+		// end full document
+	}
+}
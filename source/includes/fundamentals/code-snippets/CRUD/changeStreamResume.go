@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-event-struct
+type ChangeEvent struct {
+	OperationType string `bson:"operationType"`
+	FullDocument  Review `bson:"fullDocument"`
+}
+
+// end-event-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{bson.D{{"$match", bson.D{{"operationType", "insert"}}}}}
+
+	fmt.Println("\nBefore Disconnect:\n")
+	// begin resume token
+	cs, err := coll.Watch(ctx, pipeline)
+	if err != nil {
+		panic(err)
+	}
+
+	firstReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+	if _, err := coll.InsertOne(context.TODO(), firstReview); err != nil {
+		panic(err)
+	}
+
+	if cs.Next(ctx) {
+		var event ChangeEvent
+		if err := cs.Decode(&event); err != nil {
+			panic(err)
+		}
+		res, _ := json.Marshal(event.FullDocument)
+		fmt.Printf("%s: %s\n", event.OperationType, res)
+	}
+	if err := cs.Err(); err != nil {
+		panic(err)
+	}
+
+	// Store the token of the last processed event. A production
+	// application persists this value so it survives restarts.
+	token := cs.ResumeToken()
+	cs.Close(context.TODO())
+	// end resume token
+
+	// begin gap inserts
+	// Simulate writes that happen while the application is disconnected
+	gapReviews := []interface{}{
+		Review{Item: "Earl Grey", Rating: 8, DateOrdered: time.Date(2010, 1, 6, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Jasmine", Rating: 6, DateOrdered: time.Date(2010, 1, 7, 0, 0, 0, 0, time.Local)},
+	}
+	if _, err := coll.InsertMany(context.TODO(), gapReviews); err != nil {
+		panic(err)
+	}
+	// end gap inserts
+
+	fmt.Println("\nAfter Resuming:\n")
+	// begin resume after
+	opts := options.ChangeStream().SetResumeAfter(token)
+
+	resumed, err := coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		panic(err)
+	}
+	defer resumed.Close(context.TODO())
+
+	// The resumed stream delivers the events that occurred after the
+	// stored token, including those written during the gap
+	for i := 0; i < len(gapReviews) && resumed.Next(ctx); i++ {
+		var event ChangeEvent
+		if err := resumed.Decode(&event); err != nil {
+			panic(err)
+		}
+		res, _ := json.Marshal(event.FullDocument)
+		fmt.Printf("%s: %s\n", event.OperationType, res)
+	}
+	if err := resumed.Err(); err != nil {
+		panic(err)
+	}
+	// end resume after
+}
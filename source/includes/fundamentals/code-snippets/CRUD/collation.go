@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin insert mixed case
+	mixedCase := []interface{}{
+		Review{Item: "masala", Rating: 6, DateOrdered: time.Date(2010, 1, 2, 0, 0, 0, 0, time.Local)},
+		Review{Item: "MASALA", Rating: 5, DateOrdered: time.Date(2010, 1, 3, 0, 0, 0, 0, time.Local)},
+		Review{Item: "earl grey", Rating: 8, DateOrdered: time.Date(2010, 1, 4, 0, 0, 0, 0, time.Local)},
+	}
+	if _, err := coll.InsertMany(context.TODO(), mixedCase); err != nil {
+		panic(err)
+	}
+	// end insert mixed case
+
+	fmt.Println("\nCase-Insensitive Find:\n")
+	{
+		// begin case insensitive
+		// A strength of 2 compares base letters and accents but ignores
+		// case, so the filter matches "Masala", "masala", and "MASALA"
+		myCollation := &options.Collation{Locale: "en", Strength: 2}
+		opts := options.Find().SetCollation(myCollation)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{{"item", "masala"}}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+		// end case insensitive
+	}
+
+	fmt.Println("\nCollated Sort:\n")
+	{
+		// begin collated sort
+		// The default binary comparison sorts every uppercase letter
+		// before any lowercase letter, which places "earl grey" after
+		// "Sencha". The English collation sorts alphabetically instead.
+		myCollation := &options.Collation{Locale: "en"}
+		opts := options.Find().
+			SetSort(bson.D{{"item", 1}}).
+			SetProjection(bson.D{{"item", 1}, {"_id", 0}}).
+			SetCollation(myCollation)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Println(result.Item)
+		}
+		// end collated sort
+	}
+}
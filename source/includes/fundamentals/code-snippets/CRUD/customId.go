@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	ID          string    `bson:"_id"`
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+	coll := client.Database("tea").Collection("reviews")
+
+	fmt.Println("\nInsert with Custom ID:\n")
+	var id string
+	{
+		// begin insert custom id
+		// A custom _id must be unique in the collection, so choose a
+		// value with a natural key such as an order number. Unlike an
+		// ObjectID, it doesn't record a creation time, and the driver
+		// can't generate it for you.
+		doc := Review{
+			ID:          "order-1017-masala",
+			Item:        "Masala",
+			Rating:      10,
+			DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local),
+		}
+
+		result, err := coll.InsertOne(context.TODO(), doc)
+		if err != nil {
+			panic(err)
+		}
+
+		// InsertedID is an interface{} that holds the string you set
+		id = result.InsertedID.(string)
+		fmt.Printf("Inserted document with _id: %s\n", id)
+		// end insert custom id
+	}
+
+	fmt.Println("\nFind by Custom ID:\n")
+	{
+		// begin find custom id
+		var result Review
+		err := coll.FindOne(context.TODO(), bson.D{{"_id", id}}).Decode(&result)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				// This error means your query did not match any documents.
+				fmt.Printf("No review with _id %s\n", id)
+				return
+			}
+			panic(err)
+		}
+
+		res, _ := bson.MarshalExtJSON(result, false, false)
+		fmt.Println(string(res))
+		// end find custom id
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nDeleteOne:\n")
+	{
+		// begin delete one
+		// Three reviews match the filter, but DeleteOne removes only the
+		// first matching document
+		filter := bson.D{{"item", "Masala"}}
+
+		result, err := coll.DeleteOne(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents deleted: %d\n", result.DeletedCount)
+		// end delete one
+	}
+
+	{
+		// Reset data
+		if _, err := coll.DeleteMany(context.TODO(), bson.D{}); err != nil {
+			log.Fatal(err)
+		}
+		if _, err := coll.InsertMany(context.TODO(), docs); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println("\nData Restored\n")
+	}
+
+	fmt.Println("\nDeleteMany:\n")
+	{
+		// begin delete many
+		filter := bson.D{{"item", "Masala"}}
+
+		result, err := coll.DeleteMany(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents deleted: %d\n", result.DeletedCount)
+		// end delete many
+	}
+}
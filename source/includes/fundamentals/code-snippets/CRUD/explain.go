@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nExplain Without an Index:\n")
+	{
+		// begin explain collscan
+		filter := bson.D{{"item", "Masala"}, {"rating", bson.D{{"$gte", 9}}}}
+		explainCommand := bson.D{
+			{"explain", bson.D{{"find", "reviews"}, {"filter", filter}}},
+			{"verbosity", "executionStats"},
+		}
+
+		var result bson.M
+		err := client.Database("tea").RunCommand(context.TODO(), explainCommand).Decode(&result)
+		if err != nil {
+			panic(err)
+		}
+
+		// Without an index, the winning plan is a COLLSCAN stage that
+		// examines every document in the collection
+		queryPlanner := result["queryPlanner"].(bson.M)
+		executionStats := result["executionStats"].(bson.M)
+		output, err := json.MarshalIndent(queryPlanner["winningPlan"], "", "    ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Winning plan:\n%s\n", output)
+		fmt.Printf("Documents examined: %v\n", executionStats["totalDocsExamined"])
+		fmt.Printf("Documents returned: %v\n", executionStats["nReturned"])
+		// end explain collscan
+	}
+
+	fmt.Println("\nExplain With an Index:\n")
+	{
+		// begin explain ixscan
+		indexModel := mongo.IndexModel{Keys: bson.D{{"item", 1}, {"rating", 1}}}
+		if _, err := coll.Indexes().CreateOne(context.TODO(), indexModel); err != nil {
+			panic(err)
+		}
+
+		filter := bson.D{{"item", "Masala"}, {"rating", bson.D{{"$gte", 9}}}}
+		explainCommand := bson.D{
+			{"explain", bson.D{{"find", "reviews"}, {"filter", filter}}},
+			{"verbosity", "executionStats"},
+		}
+
+		var result bson.M
+		err := client.Database("tea").RunCommand(context.TODO(), explainCommand).Decode(&result)
+		if err != nil {
+			panic(err)
+		}
+
+		// The winning plan now contains an IXSCAN stage, and the server
+		// examines only the documents that match the filter
+		queryPlanner := result["queryPlanner"].(bson.M)
+		executionStats := result["executionStats"].(bson.M)
+		output, err := json.MarshalIndent(queryPlanner["winningPlan"], "", "    ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Winning plan:\n%s\n", output)
+		fmt.Printf("Documents examined: %v\n", executionStats["totalDocsExamined"])
+		fmt.Printf("Documents returned: %v\n", executionStats["nReturned"])
+		// end explain ixscan
+	}
+}
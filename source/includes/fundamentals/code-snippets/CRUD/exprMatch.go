@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-tea-struct
+type Tea struct {
+	Item      string `bson:"item"`
+	Rating    int32  `bson:"rating"`
+	Threshold int32  `bson:"threshold"`
+}
+
+// end-tea-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("targets").Drop(context.TODO())
+
+	// begin insert docs
+	// Each tea has its own target rating in the threshold field
+	coll := client.Database("tea").Collection("targets")
+	docs := []interface{}{
+		Tea{Item: "Masala", Rating: 9, Threshold: 8},
+		Tea{Item: "Sencha", Rating: 7, Threshold: 8},
+		Tea{Item: "Hibiscus", Rating: 5, Threshold: 4},
+		Tea{Item: "Oolong", Rating: 6, Threshold: 6},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nCompare Two Fields:\n")
+	{
+		// begin expr match
+		// A plain filter compares a field to a constant. $expr evaluates
+		// an aggregation expression, which can refer to other fields in
+		// the same document with the $ prefix.
+		filter := bson.D{{"$expr", bson.D{{"$gt", bson.A{"$rating", "$threshold"}}}}}
+
+		cursor, err := coll.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Tea
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%s: rating %d is above its threshold of %d\n", result.Item, result.Rating, result.Threshold)
+		}
+		// end expr match
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nFindOneAndUpdate with Upsert:\n")
+	{
+		// begin upsert
+		filter := bson.D{{"item", "Earl Grey"}}
+		update := bson.D{{"$set", bson.D{{"rating", 8}, {"date_ordered", time.Date(2010, 1, 4, 0, 0, 0, 0, time.Local)}}}}
+		opts := options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetUpsert(true)
+
+		var updatedDoc Review
+		err := coll.FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&updatedDoc)
+		if err != nil {
+			panic(err)
+		}
+
+		res, _ := json.Marshal(updatedDoc)
+		fmt.Println(string(res))
+		// end upsert
+	}
+
+	fmt.Println("\nFindOneAndUpdate with No Match:\n")
+	{
+		// begin no match
+		filter := bson.D{{"item", "Jasmine"}}
+		update := bson.D{{"$set", bson.D{{"rating", 6}}}}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+
+		var previousDoc Review
+		err := coll.FindOneAndUpdate(context.TODO(), filter, update, opts).Decode(&previousDoc)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				// This error means your filter did not match any documents.
+				fmt.Println("No document matched the filter, so nothing was updated")
+				return
+			}
+			panic(err)
+		}
+
+		res, _ := json.Marshal(previousDoc)
+		fmt.Println(string(res))
+		// end no match
+	}
+}
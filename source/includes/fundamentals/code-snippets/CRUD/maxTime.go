@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// The $where expression sleeps for 50 milliseconds on each document,
+	// so a query that uses it takes about 300 milliseconds. This
+	// requires server-side JavaScript to be enabled.
+	slowFilter := bson.D{{"$where", "sleep(50) || true"}}
+
+	fmt.Println("\nFind with MaxTime:\n")
+	{
+		// begin find max time
+		opts := options.Find().SetMaxTime(100 * time.Millisecond)
+
+		cursor, err := coll.Find(context.TODO(), slowFilter, opts)
+		if err == nil {
+			var results []Review
+			err = cursor.All(context.TODO(), &results)
+		}
+
+		// The server aborts the operation and returns a
+		// MaxTimeMSExpired error, which has code 50
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == 50 {
+			fmt.Printf("The server stopped the query: %s\n", cmdErr.Message)
+		} else if err != nil {
+			panic(err)
+		}
+		// end find max time
+	}
+
+	fmt.Println("\nAggregate with MaxTime:\n")
+	{
+		// begin aggregate max time
+		// The maxTime limit is enforced by the server, which stops the
+		// operation and frees its resources. A context deadline is
+		// enforced by the driver, which stops waiting for a response but
+		// can leave the operation running on the server.
+		opts := options.Aggregate().SetMaxTime(100 * time.Millisecond)
+
+		// $where isn't allowed in an aggregation, so this stage sleeps
+		// with a $function expression instead
+		matchStage := bson.D{{"$match", bson.D{{"$expr", bson.D{{"$function", bson.D{
+			{"body", "function() { sleep(50); return true; }"},
+			{"args", bson.A{}},
+			{"lang", "js"},
+		}}}}}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{matchStage}, opts)
+		if err == nil {
+			var results []Review
+			err = cursor.All(context.TODO(), &results)
+		}
+
+		if mongo.IsTimeout(err) {
+			fmt.Printf("The aggregation timed out: %v\n", err)
+		} else if err != nil {
+			panic(err)
+		}
+		// end aggregate max time
+	}
+}
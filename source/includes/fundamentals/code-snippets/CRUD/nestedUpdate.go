@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-supplier-struct
+type Address struct {
+	Street string `bson:"street"`
+	City   string `bson:"city"`
+	State  string `bson:"state"`
+}
+
+type Supplier struct {
+	Name    string  `bson:"name"`
+	Address Address `bson:"address"`
+}
+
+// end-supplier-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("suppliers").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("suppliers")
+	docs := []interface{}{
+		Supplier{Name: "Leaf & Kettle", Address: Address{Street: "12 Harbor Way", City: "Cambridge", State: "MA"}},
+		Supplier{Name: "Green Terrace", Address: Address{Street: "480 Alder St", City: "Portland", State: "OR"}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nUpdate Nested Field:\n")
+	{
+		// begin nested update
+		// Dot notation sets only the city field. Setting the whole
+		// address field instead would replace the sub-document and
+		// remove any fields you didn't include.
+		filter := bson.D{{"name", "Leaf & Kettle"}}
+		update := bson.D{{"$set", bson.D{{"address.city", "Boston"}}}}
+
+		_, err := coll.UpdateOne(context.TODO(), filter, update)
+		if err != nil {
+			panic(err)
+		}
+
+		var supplier Supplier
+		if err := coll.FindOne(context.TODO(), filter).Decode(&supplier); err != nil {
+			panic(err)
+		}
+
+		res, _ := bson.MarshalExtJSON(supplier, false, false)
+		fmt.Println(string(res))
+		// end nested update
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nNumeric Updates:\n")
+	{
+		// begin numeric updates
+		filter := bson.D{{"item", "Hibiscus"}}
+		updates := []bson.D{
+			// Adds 1 to the rating
+			{{"$inc", bson.D{{"rating", 1}}}},
+			// Multiplies the rating by 2
+			{{"$mul", bson.D{{"rating", 2}}}},
+			// Raises the rating to 8 only if it's lower than 8, so this
+			// update leaves a rating of 10 unchanged
+			{{"$max", bson.D{{"rating", 8}}}},
+			// Lowers the rating to 7 only if it's higher than 7
+			{{"$min", bson.D{{"rating", 7}}}},
+		}
+
+		for _, update := range updates {
+			_, err := coll.UpdateOne(context.TODO(), filter, update)
+			if err != nil {
+				panic(err)
+			}
+
+			var review Review
+			if err := coll.FindOne(context.TODO(), filter).Decode(&review); err != nil {
+				panic(err)
+			}
+			fmt.Printf("After %s: rating is %d\n", update[0].Key, review.Rating)
+		}
+		// end numeric updates
+	}
+}
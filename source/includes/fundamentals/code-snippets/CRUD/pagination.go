@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Item        string             `bson:"item,omitempty"`
+	Rating      int32              `bson:"rating,omitempty"`
+	DateOrdered time.Time          `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nSkip and Limit Pagination:\n")
+	{
+		// begin offset pagination
+		// Returns the second page of two reviews. The server still reads
+		// and discards every skipped document, so each page gets slower
+		// as the offset grows, and inserts or deletes between requests
+		// can shift documents across page boundaries.
+		opts := options.Find().
+			SetSort(bson.D{{"_id", 1}}).
+			SetSkip(2).
+			SetLimit(2)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("_id: %s, item: %s, rating: %d\n", result.ID.Hex(), result.Item, result.Rating)
+		}
+		// end offset pagination
+	}
+
+	fmt.Println("\nRange-Based Pagination:\n")
+	{
+		// begin range pagination
+		// Each page starts after the last _id of the previous page. The
+		// server uses the _id index to jump straight to that point, so
+		// every page costs the same no matter how far in it is.
+		pageSize := int64(2)
+		opts := options.Find().
+			SetSort(bson.D{{"_id", 1}}).
+			SetLimit(pageSize)
+
+		filter := bson.D{}
+		for page := 1; ; page++ {
+			cursor, err := coll.Find(context.TODO(), filter, opts)
+			if err != nil {
+				panic(err)
+			}
+
+			var results []Review
+			if err = cursor.All(context.TODO(), &results); err != nil {
+				panic(err)
+			}
+			if len(results) == 0 {
+				break
+			}
+
+			fmt.Printf("Page %d:\n", page)
+			for _, result := range results {
+				fmt.Printf("_id: %s, item: %s, rating: %d\n", result.ID.Hex(), result.Item, result.Rating)
+			}
+
+			lastID := results[len(results)-1].ID
+			filter = bson.D{{"_id", bson.D{{"$gt", lastID}}}}
+		}
+		// end range pagination
+	}
+}
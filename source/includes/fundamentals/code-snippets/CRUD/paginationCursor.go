@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Item        string             `bson:"item,omitempty"`
+	Rating      int32              `bson:"rating,omitempty"`
+	DateOrdered time.Time          `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-paginate
+// PageToken records the sort key of the last review on a page, so the
+// next call can start right after it
+type PageToken struct {
+	Rating int32
+	ID     primitive.ObjectID
+}
+
+// paginate returns up to pageSize reviews sorted by descending rating,
+// starting after the review that the token describes. Pass a nil token
+// to get the first page. The returned token is nil when there are no
+// more pages.
+func paginate(coll *mongo.Collection, pageSize int64, token *PageToken) ([]Review, *PageToken, error) {
+	// Sorting by _id as well as rating makes the order unique, so
+	// reviews with the same rating are never skipped or repeated
+	opts := options.Find().
+		SetSort(bson.D{{"rating", -1}, {"_id", 1}}).
+		SetLimit(pageSize)
+
+	filter := bson.D{}
+	if token != nil {
+		filter = bson.D{{"$or", bson.A{
+			bson.D{{"rating", bson.D{{"$lt", token.Rating}}}},
+			bson.D{{"rating", token.Rating}, {"_id", bson.D{{"$gt", token.ID}}}},
+		}}}
+	}
+
+	cursor, err := coll.Find(context.TODO(), filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reviews []Review
+	if err = cursor.All(context.TODO(), &reviews); err != nil {
+		return nil, nil, err
+	}
+
+	if int64(len(reviews)) < pageSize {
+		return reviews, nil, nil
+	}
+	last := reviews[len(reviews)-1]
+	return reviews, &PageToken{Rating: last.Rating, ID: last.ID}, nil
+}
+
+// end-paginate
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nPaginate Reviews:\n")
+	{
+		// begin iterate pages
+		var token *PageToken
+		for page := 1; ; page++ {
+			reviews, next, err := paginate(coll, 4, token)
+			if err != nil {
+				panic(err)
+			}
+			if len(reviews) == 0 {
+				break
+			}
+
+			fmt.Printf("Page %d:\n", page)
+			for _, review := range reviews {
+				fmt.Printf("item: %s, rating: %d\n", review.Item, review.Rating)
+			}
+
+			if next == nil {
+				break
+			}
+			token = next
+		}
+		// end iterate pages
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-tea-struct
+type CustomerReview struct {
+	Reviewer string `bson:"reviewer"`
+	Rating   int32  `bson:"rating"`
+}
+
+type Tea struct {
+	Item    string           `bson:"item"`
+	Reviews []CustomerReview `bson:"reviews"`
+}
+
+// end-tea-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("products").Drop(context.TODO())
+
+	// begin insertDocs
+	coll := client.Database("tea").Collection("products")
+	docs := []interface{}{
+		Tea{Item: "Masala", Reviews: []CustomerReview{{"Ana", 10}, {"Ben", 7}, {"Chloe", 9}, {"Dev", 6}}},
+		Tea{Item: "Sencha", Reviews: []CustomerReview{{"Eli", 8}, {"Fay", 10}, {"Gus", 5}}},
+		Tea{Item: "Hibiscus", Reviews: []CustomerReview{{"Hana", 4}, {"Ivan", 6}}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	//end insertDocs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\n$slice Projection:\n")
+	{
+		// begin slice projection
+		// Return only the first two elements of each reviews array
+		projection := bson.D{{"reviews", bson.D{{"$slice", bson.A{0, 2}}}}}
+		opts := options.Find().SetProjection(projection)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Tea
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end slice projection
+	}
+
+	fmt.Println("\n$elemMatch Projection:\n")
+	{
+		// begin elemMatch projection
+		// Return only the first element of each reviews array that
+		// matches the condition. Documents with no matching element
+		// include no reviews field.
+		projection := bson.D{
+			{"item", 1},
+			{"reviews", bson.D{{"$elemMatch", bson.D{{"rating", bson.D{{"$gte", 9}}}}}}},
+		}
+		opts := options.Find().SetProjection(projection)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Tea
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end elemMatch projection
+	}
+}
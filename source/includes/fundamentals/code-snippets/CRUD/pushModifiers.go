@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-blogpost-struct
+type BlogPost struct {
+	Title       string
+	Author      string
+	WordCount   int `bson:"word_count"`
+	LastUpdated time.Time
+	Tags        []string
+}
+
+// end-blogpost-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("sample_training").Collection("posts").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("sample_training").Collection("posts")
+	docs := []interface{}{
+		BlogPost{Title: "Annuals vs. Perennials?", Author: "Sam Lee", WordCount: 682, LastUpdated: time.Date(2023, 3, 14, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "gardening", "flower"}},
+		BlogPost{Title: "Planting Bulbs in Autumn", Author: "Priya Raman", WordCount: 915, LastUpdated: time.Date(2023, 9, 2, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "flower", "autumn"}},
+		BlogPost{Title: "Companion Planting for Tomatoes", Author: "Sam Lee", WordCount: 1204, LastUpdated: time.Date(2023, 5, 21, 0, 0, 0, 0, time.Local), Tags: []string{"gardening", "vegetables", "soil"}},
+		BlogPost{Title: "Winter Care for Houseplants", Author: "Alex Kim", WordCount: 540, LastUpdated: time.Date(2023, 12, 8, 0, 0, 0, 0, time.Local), Tags: []string{"seasons", "houseplants"}},
+		BlogPost{Title: "Building a Compost Bin", Author: "Priya Raman", WordCount: 777, LastUpdated: time.Date(2023, 6, 30, 0, 0, 0, 0, time.Local), Tags: []string{"soil", "diy", "gardening"}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nPush with Modifiers:\n")
+	{
+		// begin push modifiers
+		// $each appends every value in the list, $sort sorts the whole
+		// array after appending, and $slice then keeps the last five
+		// elements. The server applies the modifiers in that order no
+		// matter how you order them in the update document.
+		filter := bson.D{{"title", "Winter Care for Houseplants"}}
+		newTags := [][]string{
+			{"watering", "light"},
+			{"pests", "soil"},
+		}
+
+		for _, tags := range newTags {
+			update := bson.D{{"$push", bson.D{{"tags", bson.D{
+				{"$each", tags},
+				{"$sort", 1},
+				{"$slice", -5},
+			}}}}}
+
+			if _, err := coll.UpdateOne(context.TODO(), filter, update); err != nil {
+				panic(err)
+			}
+
+			var post BlogPost
+			if err := coll.FindOne(context.TODO(), filter).Decode(&post); err != nil {
+				panic(err)
+			}
+			fmt.Printf("After pushing %v: %v\n", tags, post.Tags)
+		}
+		// end push modifiers
+	}
+}
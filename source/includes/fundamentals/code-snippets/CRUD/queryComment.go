@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin enable profiler
+	// Profiling level 2 records every operation in the system.profile
+	// collection of the database
+	db := client.Database("tea")
+	if err := db.RunCommand(context.TODO(), bson.D{{"profile", 2}}).Err(); err != nil {
+		panic(err)
+	}
+	// end enable profiler
+
+	fmt.Println("\nFind with Comment:\n")
+	{
+		// begin find comment
+		// The server records the comment with the operation in the
+		// profiler output, in slow query log entries, and in the output
+		// of the currentOp command, so you can trace the operation back to
+		// the code that ran it
+		opts := options.Find().SetComment("monthly-report")
+		filter := bson.D{{"date_ordered", bson.D{{"$gte", time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)}}}}
+
+		cursor, err := coll.Find(context.TODO(), filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end find comment
+	}
+
+	fmt.Println("\nProfiler Entries:\n")
+	{
+		// begin find profile
+		filter := bson.D{{"command.comment", "monthly-report"}}
+		opts := options.Find().SetProjection(bson.D{{"op", 1}, {"ns", 1}, {"millis", 1}, {"command.comment", 1}})
+
+		cursor, err := db.Collection("system.profile").Find(context.TODO(), filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end find profile
+	}
+
+	if err := db.RunCommand(context.TODO(), bson.D{{"profile", 0}}).Err(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nDocuments Before Replace:\n")
+	{
+		cursor, err := coll.Find(context.TODO(), bson.D{{"item", "Masala"}})
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+	}
+
+	fmt.Println("\nReplaceOne:\n")
+	{
+		// begin replace one
+		// Unlike an update with $set, the replacement document takes the
+		// place of the entire matched document, so any field it omits,
+		// such as date_ordered, is removed
+		filter := bson.D{{"item", "Masala"}, {"rating", 10}}
+		replacement := Review{Item: "Masala", Rating: 7}
+
+		result, err := coll.ReplaceOne(context.TODO(), filter, replacement)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents matched: %d\n", result.MatchedCount)
+		fmt.Printf("Number of documents replaced: %d\n", result.ModifiedCount)
+		// end replace one
+	}
+
+	fmt.Println("\nDocuments After Replace:\n")
+	{
+		cursor, err := coll.Find(context.TODO(), bson.D{{"item", "Masala"}})
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+	}
+
+	fmt.Println("\nReplaceOne with Upsert:\n")
+	{
+		// begin replace upsert
+		filter := bson.D{{"item", "Earl Grey"}}
+		replacement := Review{Item: "Earl Grey", Rating: 8, DateOrdered: time.Date(2010, 1, 4, 0, 0, 0, 0, time.Local)}
+		opts := options.Replace().SetUpsert(true)
+
+		result, err := coll.ReplaceOne(context.TODO(), filter, replacement, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents matched: %d\n", result.MatchedCount)
+		fmt.Printf("Number of documents replaced: %d\n", result.ModifiedCount)
+		fmt.Printf("ID of the inserted document: %v\n", result.UpsertedID)
+		// end replace upsert
+	}
+}
@@ -46,4 +46,49 @@ func main() {
 		panic(err)
 	}
 	fmt.Printf("%s\n", output)
+
+	fmt.Println("\nServer Status:\n")
+	{
+		// start-serverstatus
+		adminDB := client.Database("admin")
+
+		var status bson.M
+		err := adminDB.RunCommand(context.TODO(), bson.D{{"serverStatus", 1}}).Decode(&status)
+		if err != nil {
+			panic(err)
+		}
+
+		// The command returns many sections. The connections section
+		// reports client connection counts, and the opcounters section
+		// reports the operations run since the server started.
+		connections := status["connections"].(bson.M)
+		fmt.Printf("Current connections: %v\n", connections["current"])
+		fmt.Printf("Available connections: %v\n", connections["available"])
+
+		opcounters := status["opcounters"].(bson.M)
+		for _, op := range []string{"insert", "query", "update", "delete", "getmore", "command"} {
+			fmt.Printf("%s: %v\n", op, opcounters[op])
+		}
+		// end-serverstatus
+	}
+
+	fmt.Println("\nRun Command Cursor:\n")
+	{
+		// start-runcommandcursor
+		// The listCollections command returns a cursor, so run it with
+		// RunCommandCursor() to iterate over the results
+		cursor, err := db.RunCommandCursor(context.TODO(), bson.D{{"listCollections", 1}})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("name: %v, type: %v\n", result["name"], result["type"])
+		}
+		// end-runcommandcursor
+	}
 }
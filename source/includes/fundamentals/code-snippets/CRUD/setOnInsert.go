@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item      string    `bson:"item,omitempty"`
+	Rating    int32     `bson:"rating,omitempty"`
+	CreatedAt time.Time `bson:"created_at,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+	coll := client.Database("tea").Collection("reviews")
+
+	fmt.Println("\nUpsert with SetOnInsert:\n")
+	{
+		// begin set on insert
+		// The first run inserts the document, so the server applies both
+		// operators. The second run matches the existing document, so the
+		// server applies only $set and created_at keeps its first value.
+		filter := bson.D{{"item", "Earl Grey"}}
+		opts := options.Update().SetUpsert(true)
+
+		for _, rating := range []int32{5, 6} {
+			update := bson.D{
+				{"$set", bson.D{{"rating", rating}}},
+				{"$setOnInsert", bson.D{{"created_at", time.Now()}}},
+			}
+
+			result, err := coll.UpdateOne(context.TODO(), filter, update, opts)
+			if err != nil {
+				panic(err)
+			}
+			fmt.Printf("Upserted: %d, modified: %d\n", result.UpsertedCount, result.ModifiedCount)
+
+			var review Review
+			if err := coll.FindOne(context.TODO(), filter).Decode(&review); err != nil {
+				panic(err)
+			}
+			fmt.Printf("rating: %d, created_at: %s\n", review.Rating, review.CreatedAt.Format(time.RFC3339Nano))
+
+			time.Sleep(1 * time.Second)
+		}
+		// end set on insert
+	}
+}
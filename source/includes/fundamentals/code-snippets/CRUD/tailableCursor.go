@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-entry-struct
+type LogEntry struct {
+	Seq       int       `bson:"seq"`
+	Message   string    `bson:"message"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+// end-entry-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("activity").Drop(context.TODO())
+
+	// begin create capped coll
+	// Tailable cursors work only on capped collections
+	db := client.Database("tea")
+	opts := options.CreateCollection().SetCapped(true).SetSizeInBytes(1048576)
+	if err := db.CreateCollection(context.TODO(), "activity", opts); err != nil {
+		panic(err)
+	}
+
+	// A tailable cursor on an empty collection closes immediately, so
+	// insert a first entry before opening the cursor
+	coll := db.Collection("activity")
+	_, err = coll.InsertOne(context.TODO(), LogEntry{Seq: 1, Message: "Shop opened", Timestamp: time.Now()})
+	if err != nil {
+		panic(err)
+	}
+	// end create capped coll
+
+	fmt.Println("\nTail Collection:\n")
+	{
+		// begin tailable cursor
+		findOpts := options.Find().
+			SetCursorType(options.TailableAwait).
+			SetMaxAwaitTime(1 * time.Second)
+
+		cursor, err := coll.Find(context.TODO(), bson.D{}, findOpts)
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		// The producer inserts new entries while the cursor is open
+		go func() {
+			for i := 2; i <= 4; i++ {
+				time.Sleep(500 * time.Millisecond)
+				entry := LogEntry{Seq: i, Message: fmt.Sprintf("Order %d brewed", i), Timestamp: time.Now()}
+				if _, err := coll.InsertOne(context.TODO(), entry); err != nil {
+					panic(err)
+				}
+			}
+		}()
+
+		// TryNext() returns false when no new document arrives within
+		// the max await time instead of blocking like Next()
+		for received := 0; received < 4; {
+			if cursor.TryNext(context.TODO()) {
+				var entry LogEntry
+				if err := cursor.Decode(&entry); err != nil {
+					panic(err)
+				}
+				fmt.Printf("seq: %d, message: %s\n", entry.Seq, entry.Message)
+				received++
+				continue
+			}
+
+			if err := cursor.Err(); err != nil {
+				panic(err)
+			}
+			// A cursor ID of 0 means the server closed the cursor
+			if cursor.ID() == 0 {
+				break
+			}
+			fmt.Println("Waiting for new entries...")
+		}
+		// end tailable cursor
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-tea-struct
+type Tea struct {
+	Item    string  `bson:"item"`
+	Ratings []int32 `bson:"ratings"`
+}
+
+// end-tea-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("ratings").Drop(context.TODO())
+
+	// begin insertDocs
+	coll := client.Database("tea").Collection("ratings")
+	docs := []interface{}{
+		Tea{Item: "Masala", Ratings: []int32{10, 4, 9, 2}},
+		Tea{Item: "Sencha", Ratings: []int32{7, 8, 10}},
+		Tea{Item: "Hibiscus", Ratings: []int32{4, 3, 6}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	//end insertDocs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nUpdateMany with Array Filters:\n")
+	{
+		// begin array filters
+		identifier := []interface{}{bson.D{{"elem", bson.D{{"$lt", 5}}}}}
+		update := bson.D{{"$set", bson.D{{"ratings.$[elem]", 0}}}}
+		opts := options.Update().
+			SetArrayFilters(options.ArrayFilters{Filters: identifier})
+
+		result, err := coll.UpdateMany(context.TODO(), bson.D{}, update, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents matched: %d\n", result.MatchedCount)
+		fmt.Printf("Number of documents modified: %d\n", result.ModifiedCount)
+		// end array filters
+	}
+
+	fmt.Println("\nDocuments After Update:\n")
+	{
+		cursor, err := coll.Find(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		var results []Tea
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+	}
+}
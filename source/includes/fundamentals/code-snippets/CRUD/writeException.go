@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	db := client.Database("tea")
+	db.Collection("ratings").Drop(context.TODO())
+
+	// begin create collection
+	// Every document must have an integer rating between 1 and 10
+	schema := bson.D{
+		{"bsonType", "object"},
+		{"required", bson.A{"item", "rating"}},
+		{"properties", bson.D{
+			{"rating", bson.D{
+				{"bsonType", "int"},
+				{"minimum", 1},
+				{"maximum", 10},
+			}},
+		}},
+	}
+	opts := options.CreateCollection().SetValidator(bson.D{{"$jsonSchema", schema}})
+	if err := db.CreateCollection(context.TODO(), "ratings", opts); err != nil {
+		panic(err)
+	}
+
+	coll := db.Collection("ratings")
+	result, err := coll.InsertOne(context.TODO(), bson.D{{"item", "Masala"}, {"rating", 9}})
+	if err != nil {
+		panic(err)
+	}
+	// end create collection
+
+	// The first document breaks the schema, and the second reuses the
+	// _id of the existing document
+	invalidDocs := []bson.D{
+		{{"item", "Sencha"}, {"rating", 15}},
+		{{"_id", result.InsertedID}, {"item", "Hibiscus"}, {"rating", 4}},
+	}
+
+	for _, doc := range invalidDocs {
+		fmt.Printf("\nInsert %v:\n\n", doc)
+
+		// begin inspect write exception
+		_, err := coll.InsertOne(context.TODO(), doc)
+
+		switch e := err.(type) {
+		case nil:
+			fmt.Println("Inserted the document")
+		case mongo.WriteException:
+			for _, writeErr := range e.WriteErrors {
+				fmt.Printf("code: %d, message: %s\n", writeErr.Code, writeErr.Message)
+
+				switch writeErr.Code {
+				case 121:
+					// The Details field explains which schema rules the
+					// document broke
+					fmt.Printf("The document failed validation: %s\n", writeErr.Details)
+				case 11000:
+					fmt.Println("A document with this _id already exists")
+				}
+			}
+			if e.WriteConcernError != nil {
+				fmt.Printf("write concern error: %s\n", e.WriteConcernError.Message)
+			}
+		default:
+			panic(err)
+		}
+		// end inspect write exception
+	}
+}
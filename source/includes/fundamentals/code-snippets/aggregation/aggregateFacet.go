@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nFacet:\n")
+	{
+		// begin facet
+		averageRatings := bson.A{
+			bson.D{{"$group", bson.D{
+				{"_id", "$item"},
+				{"average", bson.D{{"$avg", "$rating"}}},
+			}}},
+			bson.D{{"$sort", bson.D{{"_id", 1}}}},
+		}
+		ratingBuckets := bson.A{
+			bson.D{{"$bucket", bson.D{
+				{"groupBy", "$rating"},
+				{"boundaries", bson.A{0, 5, 8, 11}},
+				{"output", bson.D{{"count", bson.D{{"$sum", 1}}}}},
+			}}},
+		}
+		facetStage := bson.D{{"$facet", bson.D{
+			{"averageRatings", averageRatings},
+			{"ratingBuckets", ratingBuckets},
+		}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{facetStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+
+		// A $facet stage always outputs a single document
+		for _, result := range results[0]["averageRatings"].(bson.A) {
+			group := result.(bson.M)
+			fmt.Printf("%v had an average rating of %v\n", group["_id"], group["average"])
+		}
+		fmt.Println()
+		for _, result := range results[0]["ratingBuckets"].(bson.A) {
+			bucket := result.(bson.M)
+			fmt.Printf("Ratings starting at %v: %v reviews\n", bucket["_id"], bucket["count"])
+		}
+		// end facet
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin insert items
+	itemsColl := client.Database("tea").Collection("items")
+	itemsColl.Drop(context.TODO())
+
+	items := []interface{}{
+		bson.D{{"name", "Masala"}, {"price", 6.75}, {"origin", "India"}},
+		bson.D{{"name", "Sencha"}, {"price", 5.15}, {"origin", "Japan"}},
+		bson.D{{"name", "Hibiscus"}, {"price", 4.95}, {"origin", "Egypt"}},
+	}
+
+	if _, err := itemsColl.InsertMany(context.TODO(), items); err != nil {
+		panic(err)
+	}
+	// end insert items
+
+	fmt.Println("\nLookup:\n")
+	{
+		// begin lookup
+		lookupStage := bson.D{
+			{"$lookup", bson.D{
+				{"from", "items"},
+				{"localField", "item"},
+				{"foreignField", "name"},
+				{"as", "item_details"},
+			}}}
+		unwindStage := bson.D{{"$unwind", "$item_details"}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{lookupStage, unwindStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			details := result["item_details"].(bson.M)
+			fmt.Printf("%v (rating %v): $%v, from %v\n", result["item"], result["rating"], details["price"], details["origin"])
+		}
+		// end lookup
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	client.Database("tea").Collection("item_stats").Drop(context.TODO())
+
+	// begin merge pipeline
+	groupStage := bson.D{
+		{"$group", bson.D{
+			{"_id", "$item"},
+			{"average", bson.D{{"$avg", "$rating"}}},
+			{"review_count", bson.D{{"$sum", 1}}},
+		}}}
+	mergeStage := bson.D{
+		{"$merge", bson.D{
+			{"into", "item_stats"},
+			{"on", "_id"},
+			{"whenMatched", "merge"},
+			{"whenNotMatched", "insert"},
+		}}}
+	pipeline := mongo.Pipeline{groupStage, mergeStage}
+	// end merge pipeline
+
+	fmt.Println("\nFirst Merge:\n")
+	{
+		// begin first merge
+		cursor, err := coll.Aggregate(context.TODO(), pipeline)
+		if err != nil {
+			panic(err)
+		}
+		cursor.Close(context.TODO())
+		// end first merge
+	}
+
+	fmt.Println("\nDocuments in item_stats After First Merge:\n")
+	{
+		cursor, err := client.Database("tea").Collection("item_stats").Find(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%v: average %v across %v reviews\n", result["_id"], result["average"], result["review_count"])
+		}
+	}
+
+	{
+		// Change source data
+		newDocs := []interface{}{
+			Review{Item: "Masala", Rating: 4, DateOrdered: time.Date(2010, 1, 3, 0, 0, 0, 0, time.Local)},
+			Review{Item: "Oolong", Rating: 9, DateOrdered: time.Date(2010, 1, 6, 0, 0, 0, 0, time.Local)},
+		}
+		if _, err := coll.InsertMany(context.TODO(), newDocs); err != nil {
+			log.Fatal(err)
+		}
+		if _, err := coll.DeleteMany(context.TODO(), bson.D{{"item", "Hibiscus"}}); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println("\nAdded Masala and Oolong reviews, removed Hibiscus reviews\n")
+	}
+
+	fmt.Println("\nSecond Merge:\n")
+	{
+		// begin second merge
+		// The Masala document is updated and an Oolong document is
+		// inserted. Unlike $out, $merge leaves the Hibiscus document in
+		// place even though no Hibiscus reviews remain.
+		cursor, err := coll.Aggregate(context.TODO(), pipeline)
+		if err != nil {
+			panic(err)
+		}
+		cursor.Close(context.TODO())
+		// end second merge
+	}
+
+	fmt.Println("\nDocuments in item_stats After Second Merge:\n")
+	{
+		cursor, err := client.Database("tea").Collection("item_stats").Find(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%v: average %v across %v reviews\n", result["_id"], result["average"], result["review_count"])
+		}
+	}
+}
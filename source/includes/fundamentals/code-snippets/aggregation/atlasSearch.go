@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("search_reviews")
+	docs := []Review{
+		{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	// Upsert each document so that rerunning the example doesn't create
+	// duplicates. Dropping the collection would also drop its index.
+	for _, doc := range docs {
+		filter := bson.D{{"item", doc.Item}, {"date_ordered", doc.DateOrdered}}
+		opts := options.Replace().SetUpsert(true)
+		if _, err := coll.ReplaceOne(context.TODO(), filter, doc, opts); err != nil {
+			panic(err)
+		}
+	}
+	// end insert docs
+
+	fmt.Println("\nAtlas Search:\n")
+	{
+		// begin atlas search
+		// The $search stage runs only on Atlas clusters, and must be the
+		// first stage in the pipeline. Before you run this example, create
+		// an Atlas Search index named "default" on the search_reviews
+		// collection. Atlas indexes new documents asynchronously, so
+		// documents inserted moments ago might not appear in the results
+		// yet.
+		searchStage := bson.D{
+			{"$search", bson.D{
+				{"index", "default"},
+				{"text", bson.D{
+					{"query", "masala"},
+					{"path", "item"},
+				}},
+			}}}
+		projectStage := bson.D{
+			{"$project", bson.D{
+				{"item", 1},
+				{"rating", 1},
+				{"_id", 0},
+				{"score", bson.D{{"$meta", "searchScore"}}},
+			}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{searchStage, projectStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%v (rating %v): score %v\n", result["item"], result["rating"], result["score"])
+		}
+		// end atlas search
+	}
+}
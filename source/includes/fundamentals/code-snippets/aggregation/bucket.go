@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nBucket:\n")
+	boundaries := []int32{1, 5, 8, 11}
+	var manualBuckets []bson.M
+	{
+		// begin bucket
+		// Each boundary is the inclusive lower bound of a bucket, so this
+		// stage groups ratings into [1, 5), [5, 8), and [8, 11). Every
+		// rating falls within the boundaries, so the stage doesn't need a
+		// default bucket.
+		bucketStage := bson.D{{"$bucket", bson.D{
+			{"groupBy", "$rating"},
+			{"boundaries", boundaries},
+			{"output", bson.D{{"count", bson.D{{"$sum", 1}}}}},
+		}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{bucketStage})
+		if err != nil {
+			panic(err)
+		}
+
+		if err = cursor.All(context.TODO(), &manualBuckets); err != nil {
+			panic(err)
+		}
+		for _, bucket := range manualBuckets {
+			fmt.Printf("Ratings starting at %v: %v reviews\n", bucket["_id"], bucket["count"])
+		}
+		// end bucket
+	}
+
+	fmt.Println("\nBucket Auto:\n")
+	var autoBuckets []bson.M
+	{
+		// begin bucket auto
+		// The server chooses the boundaries so that each of the three
+		// buckets holds about the same number of documents
+		bucketAutoStage := bson.D{{"$bucketAuto", bson.D{
+			{"groupBy", "$rating"},
+			{"buckets", 3},
+			{"output", bson.D{{"count", bson.D{{"$sum", 1}}}}},
+		}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{bucketAutoStage})
+		if err != nil {
+			panic(err)
+		}
+
+		if err = cursor.All(context.TODO(), &autoBuckets); err != nil {
+			panic(err)
+		}
+
+		// The _id of each bucket contains its min and max boundaries
+		for _, bucket := range autoBuckets {
+			bounds := bucket["_id"].(bson.M)
+			fmt.Printf("Ratings from %v to %v: %v reviews\n", bounds["min"], bounds["max"], bucket["count"])
+		}
+		// end bucket auto
+	}
+
+	fmt.Println("\nCompare Histograms:\n")
+	{
+		fmt.Printf("%-24s%s\n", "$bucket", "$bucketAuto")
+		for i := 0; i < len(manualBuckets) || i < len(autoBuckets); i++ {
+			var manual, auto string
+			if i < len(manualBuckets) {
+				b := manualBuckets[i]
+				// The _id of each bucket is its lower boundary, and the
+				// next boundary is its exclusive upper bound
+				var label string
+				for j, lower := range boundaries[:len(boundaries)-1] {
+					if lower == b["_id"].(int32) {
+						label = fmt.Sprintf("[%d, %d)", lower, boundaries[j+1])
+					}
+				}
+				manual = fmt.Sprintf("%s %s", label, strings.Repeat("*", int(b["count"].(int32))))
+			}
+			if i < len(autoBuckets) {
+				b := autoBuckets[i]
+				bounds := b["_id"].(bson.M)
+				auto = fmt.Sprintf("%v-%v %s", bounds["min"], bounds["max"], strings.Repeat("*", int(b["count"].(int32))))
+			}
+			fmt.Printf("%-24s%s\n", manual, auto)
+		}
+	}
+}
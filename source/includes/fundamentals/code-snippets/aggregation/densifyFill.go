@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-reading-struct
+type Reading struct {
+	Date        time.Time `bson:"date"`
+	Temperature float64   `bson:"temperature"`
+}
+
+// end-reading-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("greenhouse").Drop(context.TODO())
+
+	// begin insert docs
+	// There are no readings for March 2, 4, or 5
+	coll := client.Database("tea").Collection("greenhouse")
+	docs := []interface{}{
+		Reading{Date: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Temperature: 21.5},
+		Reading{Date: time.Date(2022, 3, 3, 0, 0, 0, 0, time.UTC), Temperature: 22.0},
+		Reading{Date: time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC), Temperature: 20.5},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nDensify and Fill:\n")
+	{
+		// begin densify fill
+		// $densify adds a document for each missing day between the
+		// earliest and latest dates. The new documents contain only the
+		// date field, so $fill sets their temperature to the last known
+		// value ("locf" stands for last observation carried forward).
+		densifyStage := bson.D{
+			{"$densify", bson.D{
+				{"field", "date"},
+				{"range", bson.D{
+					{"step", 1},
+					{"unit", "day"},
+					{"bounds", "full"},
+				}},
+			}}}
+		fillStage := bson.D{
+			{"$fill", bson.D{
+				{"sortBy", bson.D{{"date", 1}}},
+				{"output", bson.D{
+					{"temperature", bson.D{{"method", "locf"}}},
+				}},
+			}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{densifyStage, fillStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Reading
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%s: %.1f\n", result.Date.UTC().Format("2006-01-02"), result.Temperature)
+		}
+		// end densify fill
+	}
+}
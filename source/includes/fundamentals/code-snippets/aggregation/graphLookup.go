@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-employee-struct
+type Employee struct {
+	ID        int32  `bson:"_id"`
+	Name      string `bson:"name"`
+	ManagerID int32  `bson:"managerId,omitempty"`
+}
+
+// end-employee-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("employees").Drop(context.TODO())
+
+	// begin insert docs
+	// The owner has no manager, so the omitempty tag leaves out managerId
+	coll := client.Database("tea").Collection("employees")
+	docs := []interface{}{
+		Employee{ID: 1, Name: "Dana"},
+		Employee{ID: 2, Name: "Eli", ManagerID: 1},
+		Employee{ID: 3, Name: "Farah", ManagerID: 1},
+		Employee{ID: 4, Name: "Gabe", ManagerID: 2},
+		Employee{ID: 5, Name: "Hiro", ManagerID: 4},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nReporting Chains:\n")
+	{
+		// begin graph lookup
+		// Starting from each employee's managerId, $graphLookup finds the
+		// employee with that _id, then repeats with that employee's
+		// managerId until it reaches someone with no manager. The
+		// depthField records how many steps away each manager is.
+		graphLookupStage := bson.D{
+			{"$graphLookup", bson.D{
+				{"from", "employees"},
+				{"startWith", "$managerId"},
+				{"connectFromField", "managerId"},
+				{"connectToField", "_id"},
+				{"as", "reportingChain"},
+				{"depthField", "level"},
+			}}}
+		sortStage := bson.D{{"$sort", bson.D{{"_id", 1}}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{graphLookupStage, sortStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []struct {
+			Name           string `bson:"name"`
+			ReportingChain []struct {
+				Name  string `bson:"name"`
+				Level int64  `bson:"level"`
+			} `bson:"reportingChain"`
+		}
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			// The server doesn't guarantee the order of the matched
+			// documents, so sort them by depth
+			chain := result.ReportingChain
+			sort.Slice(chain, func(i, j int) bool { return chain[i].Level < chain[j].Level })
+
+			names := []string{result.Name}
+			for _, manager := range chain {
+				names = append(names, manager.Name)
+			}
+			fmt.Println(strings.Join(names, " -> "))
+		}
+		// end graph lookup
+	}
+}
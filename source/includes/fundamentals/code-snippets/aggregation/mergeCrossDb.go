@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.UTC)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.UTC)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.UTC)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.UTC)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.UTC)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.UTC)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	client.Database("reports").Collection("daily").Drop(context.TODO())
+
+	fmt.Println("\nMerge into Another Database:\n")
+	{
+		// begin merge cross db
+		// The into document names a database and collection, so the
+		// results can live apart from the source data. The server
+		// creates the reports database and daily collection if they
+		// don't exist. Rerun the pipeline to refresh the results as the
+		// reviews change.
+		groupStage := bson.D{
+			{"$group", bson.D{
+				{"_id", bson.D{{"$dateToString", bson.D{
+					{"format", "%Y-%m-%d"},
+					{"date", "$date_ordered"},
+				}}}},
+				{"orders", bson.D{{"$sum", 1}}},
+				{"average", bson.D{{"$avg", "$rating"}}},
+			}}}
+		mergeStage := bson.D{
+			{"$merge", bson.D{
+				{"into", bson.D{{"db", "reports"}, {"coll", "daily"}}},
+				{"on", "_id"},
+				{"whenMatched", "replace"},
+				{"whenNotMatched", "insert"},
+			}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{groupStage, mergeStage})
+		if err != nil {
+			panic(err)
+		}
+		cursor.Close(context.TODO())
+		// end merge cross db
+	}
+
+	fmt.Println("\nDocuments in reports.daily:\n")
+	{
+		// begin find merged
+		opts := options.Find().SetSort(bson.D{{"_id", 1}})
+		cursor, err := client.Database("reports").Collection("daily").Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%v: %v orders, average rating %v\n", result["_id"], result["orders"], result["average"])
+		}
+		// end find merged
+	}
+}
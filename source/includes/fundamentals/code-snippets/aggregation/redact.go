@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-recipe-structs
+type Section struct {
+	Title       string `bson:"title"`
+	AccessLevel int32  `bson:"accessLevel"`
+	Content     string `bson:"content"`
+}
+
+type Recipe struct {
+	Name        string    `bson:"name"`
+	AccessLevel int32     `bson:"accessLevel"`
+	Sections    []Section `bson:"sections"`
+}
+
+// end-recipe-structs
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("recipes").Drop(context.TODO())
+
+	// begin insert docs
+	// Higher access levels hold more sensitive information
+	coll := client.Database("tea").Collection("recipes")
+	docs := []interface{}{
+		Recipe{Name: "Masala Chai", AccessLevel: 1, Sections: []Section{
+			{Title: "Ingredients", AccessLevel: 1, Content: "Black tea, milk, cardamom, ginger"},
+			{Title: "Spice Ratios", AccessLevel: 2, Content: "4 parts cardamom to 1 part ginger"},
+			{Title: "Supplier Pricing", AccessLevel: 3, Content: "$14 per kilogram"},
+		}},
+		Recipe{Name: "House Blend", AccessLevel: 3, Sections: []Section{
+			{Title: "Ingredients", AccessLevel: 3, Content: "Sencha, jasmine, lemongrass"},
+		}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	for _, clearance := range []int32{1, 3} {
+		fmt.Printf("\nRedact for Clearance %d:\n\n", clearance)
+		// begin redact
+		// $redact evaluates the expression at the top level of each
+		// document. $$DESCEND keeps the current level and evaluates
+		// each embedded document in turn, and $$PRUNE removes the
+		// current level and everything inside it.
+		redactStage := bson.D{{"$redact", bson.D{{"$cond", bson.D{
+			{"if", bson.D{{"$lte", bson.A{"$accessLevel", clearance}}}},
+			{"then", "$$DESCEND"},
+			{"else", "$$PRUNE"},
+		}}}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{redactStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Recipe
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Println(result.Name)
+			for _, section := range result.Sections {
+				fmt.Printf("  %s: %s\n", section.Title, section.Content)
+			}
+		}
+		// end redact
+	}
+}
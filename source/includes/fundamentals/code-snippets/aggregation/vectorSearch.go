@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-tea-struct
+type TeaDescription struct {
+	Item        string    `bson:"item"`
+	Description string    `bson:"description"`
+	Embedding   []float32 `bson:"embedding"`
+}
+
+// end-tea-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	// begin insert docs
+	// These embeddings have four dimensions to keep the example short.
+	// Embeddings from a model have hundreds or thousands of dimensions,
+	// and the vector search index must specify the same number.
+	coll := client.Database("tea").Collection("descriptions")
+	docs := []TeaDescription{
+		{Item: "Masala", Description: "Spiced black tea with ginger and cardamom", Embedding: []float32{0.91, 0.12, 0.33, 0.05}},
+		{Item: "Sencha", Description: "Grassy steamed green tea", Embedding: []float32{0.08, 0.87, 0.21, 0.40}},
+		{Item: "Hibiscus", Description: "Tart floral herbal infusion", Embedding: []float32{0.15, 0.30, 0.92, 0.11}},
+		{Item: "Chai Rooibos", Description: "Caffeine-free red bush tea with warm spices", Embedding: []float32{0.82, 0.10, 0.45, 0.09}},
+	}
+
+	// Upsert each document so that rerunning the example doesn't create
+	// duplicates. Dropping the collection would also drop its index.
+	for _, doc := range docs {
+		opts := options.Replace().SetUpsert(true)
+		if _, err := coll.ReplaceOne(context.TODO(), bson.D{{"item", doc.Item}}, doc, opts); err != nil {
+			panic(err)
+		}
+	}
+	// end insert docs
+
+	fmt.Println("\nVector Search:\n")
+	{
+		// begin vector search
+		// The $vectorSearch stage runs only on Atlas clusters. Before you
+		// run this example, create an Atlas Vector Search index named
+		// "vector_index" on the embedding field with 4 dimensions.
+		// In an application, queryVector comes from the same model that
+		// produced the stored embeddings, applied to the search text.
+		queryVector := []float32{0.88, 0.11, 0.40, 0.07}
+
+		vectorSearchStage := bson.D{
+			{"$vectorSearch", bson.D{
+				{"index", "vector_index"},
+				{"path", "embedding"},
+				{"queryVector", queryVector},
+				{"numCandidates", 100},
+				{"limit", 2},
+			}}}
+		projectStage := bson.D{
+			{"$project", bson.D{
+				{"item", 1},
+				{"description", 1},
+				{"_id", 0},
+				{"score", bson.D{{"$meta", "vectorSearchScore"}}},
+			}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{vectorSearchStage, projectStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []bson.M
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%v: %v (score %v)\n", result["item"], result["description"], result["score"])
+		}
+		// end vector search
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+	environmentCredentials(uri)
+	//explicitCredentials(uri)
+}
+
+func environmentCredentials(uri string) {
+	// start-aws-environment
+	// With no username or password, the driver reads the credentials
+	// from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and
+	// AWS_SESSION_TOKEN environment variables. If those aren't set, it
+	// uses the IAM role of the EC2 instance, ECS task, or Lambda function
+	// that runs the application.
+	credential := options.Credential{
+		AuthMechanism: "MONGODB-AWS",
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetAuth(credential)
+	// end-aws-environment
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(context.TODO())
+
+	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+		panic(err)
+	}
+	fmt.Println("Successfully authenticated with AWS credentials from the environment")
+}
+
+func explicitCredentials(uri string) {
+	// start-aws-explicit
+	// Pass the access key ID and secret access key as the username and
+	// password. Temporary credentials also require a session token.
+	credential := options.Credential{
+		AuthMechanism: "MONGODB-AWS",
+		Username:      "<accessKeyID>",
+		Password:      "<secretAccessKey>",
+		AuthMechanismProperties: map[string]string{
+			"AWS_SESSION_TOKEN": "<sessionToken>",
+		},
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetAuth(credential)
+	// end-aws-explicit
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(context.TODO())
+
+	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+		panic(err)
+	}
+	fmt.Println("Successfully authenticated with explicit AWS credentials")
+}
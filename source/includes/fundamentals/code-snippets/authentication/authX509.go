@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin tls config
+	// Load the client certificate and private key that the server uses
+	// to identify the user
+	cert, err := tls.LoadX509KeyPair("path/to/client.pem", "path/to/client-key.pem")
+	if err != nil {
+		panic(err)
+	}
+
+	// Load the certificate authority that signed the server certificate
+	caCert, err := os.ReadFile("path/to/ca.pem")
+	if err != nil {
+		panic(err)
+	}
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(caCert); !ok {
+		log.Fatal("Failed to parse the CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+	}
+	// end tls config
+
+	// begin x509 credential
+	// X.509 users are defined in the $external database rather than in
+	// a regular database, so the authentication source must be
+	// $external. The driver uses $external for MONGODB-X509 when you
+	// don't set AuthSource, and the username comes from the certificate
+	// subject.
+	credential := options.Credential{
+		AuthMechanism: "MONGODB-X509",
+	}
+	clientOpts := options.Client().
+		ApplyURI(uri).
+		SetTLSConfig(tlsConfig).
+		SetAuth(credential)
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	// end x509 credential
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+		panic(err)
+	}
+	fmt.Println("Successfully authenticated with MONGODB-X509")
+}
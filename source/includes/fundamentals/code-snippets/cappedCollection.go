@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-entry-struct
+type LogEntry struct {
+	Seq       int       `bson:"seq"`
+	Message   string    `bson:"message"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+// end-entry-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("logs").Drop(context.TODO())
+
+	// begin create capped coll
+	// A capped collection has a fixed size and preserves insertion order.
+	// Once it reaches either limit, each insert removes the oldest
+	// document, which suits rolling logs and recent-activity feeds.
+	db := client.Database("tea")
+	opts := options.CreateCollection().
+		SetCapped(true).
+		SetSizeInBytes(1048576).
+		SetMaxDocuments(1000)
+
+	if err := db.CreateCollection(context.TODO(), "logs", opts); err != nil {
+		panic(err)
+	}
+	// end create capped coll
+
+	// begin insert entries
+	coll := db.Collection("logs")
+
+	var docs []interface{}
+	for i := 1; i <= 1005; i++ {
+		docs = append(docs, LogEntry{Seq: i, Message: fmt.Sprintf("Order %d brewed", i), Timestamp: time.Now()})
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert entries
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nOldest Remaining Entries:\n")
+	{
+		// begin find oldest
+		// The first five entries were evicted to stay within the
+		// 1000-document limit
+		count, err := coll.CountDocuments(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Number of documents in the collection: %d\n", count)
+
+		opts := options.Find().SetSort(bson.D{{"$natural", 1}}).SetLimit(3)
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []LogEntry
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%d: %s\n", result.Seq, result.Message)
+		}
+		// end find oldest
+	}
+}
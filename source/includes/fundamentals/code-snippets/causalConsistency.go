@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nCausally Consistent Session:\n")
+	{
+		// begin causal consistency
+		// In a causally consistent session, each read waits until the
+		// server it runs on has applied the session's earlier writes, so
+		// a read from a secondary still sees the write from the primary.
+		// The guarantee holds only when the session's writes use a
+		// majority write concern and its reads use a majority read
+		// concern.
+		opts := options.Session().SetCausalConsistency(true)
+		session, err := client.StartSession(opts)
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		writeColl := client.Database("tea").Collection("reviews",
+			options.Collection().SetWriteConcern(writeconcern.Majority()))
+		readColl := client.Database("tea").Collection("reviews",
+			options.Collection().
+				SetReadConcern(readconcern.Majority()).
+				SetReadPreference(readpref.Secondary()))
+
+		err = mongo.WithSession(context.TODO(), session, func(sc mongo.SessionContext) error {
+			newReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+			if _, err := writeColl.InsertOne(sc, newReview); err != nil {
+				return err
+			}
+
+			var result Review
+			if err := readColl.FindOne(sc, bson.D{{"item", "Oolong"}}).Decode(&result); err != nil {
+				return err
+			}
+
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		// end causal consistency
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin command monitor
+	var mu sync.Mutex
+	var timings []string
+	databases := make(map[int64]string)
+
+	cmdMonitor := &event.CommandMonitor{
+		Started: func(_ context.Context, e *event.CommandStartedEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			// Save the database name so the finished events can report it
+			databases[e.RequestID] = e.DatabaseName
+			log.Printf("started %s on %s\n", e.CommandName, e.DatabaseName)
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			timings = append(timings, fmt.Sprintf("%s on %s succeeded in %v", e.CommandName, databases[e.RequestID], e.Duration))
+			delete(databases, e.RequestID)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			timings = append(timings, fmt.Sprintf("%s on %s failed in %v: %s", e.CommandName, databases[e.RequestID], e.Duration, e.Failure))
+			delete(databases, e.RequestID)
+		},
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetMonitor(cmdMonitor)
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	// end command monitor
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nMonitored Operations:\n")
+	{
+		// begin monitored operations
+		var result Review
+		if err := coll.FindOne(context.TODO(), bson.D{{"item", "Sencha"}}).Decode(&result); err != nil {
+			panic(err)
+		}
+
+		newReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+		if _, err := coll.InsertOne(context.TODO(), newReview); err != nil {
+			panic(err)
+		}
+		// end monitored operations
+	}
+
+	fmt.Println("\nCollected Timings:\n")
+	{
+		mu.Lock()
+		defer mu.Unlock()
+		for _, timing := range timings {
+			fmt.Println(timing)
+		}
+	}
+}
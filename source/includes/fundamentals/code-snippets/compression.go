@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin compression options
+	// The driver and the server negotiate the algorithm when the
+	// connection opens. They use the first algorithm in this list that
+	// the server also supports, or send uncompressed messages if none
+	// match.
+	opts := options.Client().
+		ApplyURI(uri).
+		SetCompressors([]string{"zstd", "snappy", "zlib"}).
+		// Level 6 balances speed and size. Valid values range from -1 to
+		// 9, and apply only when zlib is the negotiated algorithm.
+		SetZlibLevel(6)
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	// end compression options
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nCompressed InsertMany:\n")
+	{
+		// begin compressed insert
+		// Compression pays off for large payloads like this batch, and
+		// on bandwidth-limited links between the application and the
+		// server. It costs extra CPU time on both sides to compress and
+		// decompress each message.
+		var batch []interface{}
+		for i := 0; i < 1000; i++ {
+			batch = append(batch, Review{Item: "Sencha", Rating: int32(i%10 + 1), DateOrdered: time.Date(2010, 1, 1, 0, 0, 0, 0, time.Local).AddDate(0, 0, i)})
+		}
+
+		result, err := coll.InsertMany(context.TODO(), batch)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+		// end compressed insert
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin pool options
+	opts := options.Client().
+		ApplyURI(uri).
+		// Maximum number of connections to each server, including those
+		// in use. Operations wait for a connection when the pool is full.
+		SetMaxPoolSize(100).
+		// Number of connections to each server that the driver keeps
+		// open, even when they're idle
+		SetMinPoolSize(10).
+		// How long a connection can stay idle before the driver closes it
+		SetMaxConnIdleTime(30 * time.Second).
+		// Maximum number of connections that each pool can establish at
+		// the same time, which limits connection storms at startup
+		SetMaxConnecting(5)
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	// end pool options
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nConcurrent Finds:\n")
+	{
+		// begin concurrent finds
+		// Each goroutine checks out a connection from the pool for the
+		// duration of its operation and returns it afterward
+		items := []string{"Masala", "Sencha", "Hibiscus", "Masala", "Sencha", "Hibiscus"}
+
+		var wg sync.WaitGroup
+		for i, item := range items {
+			wg.Add(1)
+			go func(worker int, item string) {
+				defer wg.Done()
+
+				cursor, err := coll.Find(context.TODO(), bson.D{{"item", item}})
+				if err != nil {
+					log.Println(err)
+					return
+				}
+
+				var results []Review
+				if err = cursor.All(context.TODO(), &results); err != nil {
+					log.Println(err)
+					return
+				}
+				fmt.Printf("Worker %d found %d %s reviews\n", worker, len(results), item)
+			}(i, item)
+		}
+		wg.Wait()
+		// end concurrent finds
+	}
+}
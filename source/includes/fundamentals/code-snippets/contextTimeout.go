@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nTimeout:\n")
+	{
+		// begin context timeout
+		// The $function expression sleeps for one second on each
+		// document, so the aggregation takes longer than the two second
+		// deadline. This requires server-side JavaScript to be enabled.
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		matchStage := bson.D{{"$match", bson.D{{"$expr", bson.D{{"$function", bson.D{
+			{"body", "function() { sleep(1000); return true; }"},
+			{"args", bson.A{}},
+			{"lang", "js"},
+		}}}}}}}
+		cursor, err := coll.Aggregate(ctx, mongo.Pipeline{matchStage})
+		if err == nil {
+			var results []Review
+			err = cursor.All(ctx, &results)
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			fmt.Println("The aggregation exceeded its deadline")
+		}
+		fmt.Printf("mongo.IsTimeout: %v, error: %v\n", mongo.IsTimeout(err), err)
+		// end context timeout
+	}
+
+	fmt.Println("\nCancel:\n")
+	{
+		// begin context cancel
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		opts := options.Find().SetBatchSize(2)
+		cursor, err := coll.Find(ctx, bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		for cursor.Next(ctx) {
+			var review Review
+			if err := cursor.Decode(&review); err != nil {
+				panic(err)
+			}
+			fmt.Printf("item: %s, rating: %d\n", review.Item, review.Rating)
+
+			// Next() still returns the documents left in the current
+			// batch, then fails when it needs to fetch the next batch
+			// with the cancelled context
+			cancel()
+		}
+
+		err = cursor.Err()
+		if errors.Is(err, context.Canceled) {
+			fmt.Println("The cursor stopped because its context was cancelled")
+		}
+		fmt.Printf("error: %v\n", err)
+		// end context cancel
+	}
+}
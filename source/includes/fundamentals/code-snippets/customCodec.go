@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin registry
+	// A registry controls how the driver marshals and unmarshals every Go
+	// type. Setting it on the client applies it to all operations that
+	// use the client. This registry starts from the default codecs and
+	// replaces only the ones for time.Time.
+	tTime := reflect.TypeOf(time.Time{})
+
+	rb := bson.NewRegistryBuilder()
+	rb.RegisterTypeEncoder(tTime, bsoncodec.ValueEncoderFunc(
+		func(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+			if !val.IsValid() || val.Type() != tTime {
+				return bsoncodec.ValueEncoderError{Name: "epochMillisEncoder", Types: []reflect.Type{tTime}, Received: val}
+			}
+			return vw.WriteInt64(val.Interface().(time.Time).UnixMilli())
+		}))
+	rb.RegisterTypeDecoder(tTime, bsoncodec.ValueDecoderFunc(
+		func(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+			if !val.CanSet() || val.Type() != tTime {
+				return bsoncodec.ValueDecoderError{Name: "epochMillisDecoder", Types: []reflect.Type{tTime}, Received: val}
+			}
+			millis, err := vr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			val.Set(reflect.ValueOf(time.UnixMilli(millis)))
+			return nil
+		}))
+	reg := rb.Build()
+
+	clientOpts := options.Client().ApplyURI(uri).SetRegistry(reg)
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	// end registry
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nStored Document:\n")
+	{
+		// begin stored format
+		// Decoding into a bson.M shows the value as it's stored, an int64
+		// holding milliseconds since the Unix epoch
+		var result bson.M
+		if err := coll.FindOne(context.TODO(), bson.D{{"item", "Hibiscus"}}).Decode(&result); err != nil {
+			panic(err)
+		}
+		fmt.Printf("date_ordered: %v (%T)\n", result["date_ordered"], result["date_ordered"])
+		// end stored format
+	}
+
+	fmt.Println("\nDecoded Review:\n")
+	{
+		// begin decoded review
+		// The custom decoder converts the stored int64 back to a time.Time
+		var result Review
+		if err := coll.FindOne(context.TODO(), bson.D{{"item", "Hibiscus"}}).Decode(&result); err != nil {
+			panic(err)
+		}
+		fmt.Printf("DateOrdered: %v\n", result.DateOrdered)
+		// end decoded review
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-order-struct
+type Order struct {
+	Item  string               `bson:"item"`
+	Price primitive.Decimal128 `bson:"price"`
+}
+
+// end-order-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("orders").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("orders")
+
+	var docs []interface{}
+	for _, order := range []struct{ item, price string }{
+		{"Masala", "19.99"},
+		{"Sencha", "0.10"},
+		{"Hibiscus", "0.20"},
+	} {
+		price, err := primitive.ParseDecimal128(order.price)
+		if err != nil {
+			panic(err)
+		}
+		docs = append(docs, Order{Item: order.item, Price: price})
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nFind:\n")
+	{
+		// begin find decimals
+		// The values round-trip exactly. The same prices stored as float64
+		// would be binary approximations, so 0.10 + 0.20 would not equal
+		// 0.30.
+		cursor, err := coll.Find(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Order
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%s: %s\n", result.Item, result.Price.String())
+		}
+		// end find decimals
+	}
+
+	fmt.Println("\nSum Prices:\n")
+	{
+		// begin sum decimals
+		// The Decimal128 type has no arithmetic methods in Go. Perform the
+		// arithmetic on the server, which keeps the result in decimal
+		// form, or use the BigInt() method to get the exact unscaled value
+		// and exponent before computing in your application.
+		groupStage := bson.D{
+			{"$group", bson.D{
+				{"_id", nil},
+				{"total", bson.D{{"$sum", "$price"}}},
+			}}}
+
+		cursor, err := coll.Aggregate(context.TODO(), mongo.Pipeline{groupStage})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []struct {
+			Total primitive.Decimal128 `bson:"total"`
+		}
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Total price: %s\n", results[0].Total.String())
+		// end sum decimals
+	}
+}
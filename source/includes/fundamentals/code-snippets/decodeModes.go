@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	// The document has brewer and notes fields that the Review struct
+	// doesn't define
+	coll := client.Database("tea").Collection("reviews")
+	doc := bson.D{
+		{"item", "Masala"},
+		{"rating", 10},
+		{"date_ordered", time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		{"brewer", "Priya"},
+		{"notes", "Steeped for four minutes"},
+	}
+
+	result, err := coll.InsertOne(context.TODO(), doc)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Inserted document with _id: %v\n", result.InsertedID)
+
+	filter := bson.D{{"item", "Masala"}}
+
+	fmt.Println("\nDecode into a Struct:\n")
+	{
+		// begin decode struct
+		// The driver ignores fields that have no matching struct field,
+		// so the brewer, notes, and _id values are dropped
+		var review Review
+		if err := coll.FindOne(context.TODO(), filter).Decode(&review); err != nil {
+			panic(err)
+		}
+		fmt.Printf("%+v\n", review)
+		// end decode struct
+	}
+
+	fmt.Println("\nDecode into a bson.M:\n")
+	{
+		// begin decode map
+		// A bson.M keeps every field, but Go maps are unordered, so the
+		// fields can print in any order
+		var review bson.M
+		if err := coll.FindOne(context.TODO(), filter).Decode(&review); err != nil {
+			panic(err)
+		}
+		fmt.Println(review)
+		// end decode map
+	}
+
+	fmt.Println("\nDecode into a bson.D:\n")
+	{
+		// begin decode ordered
+		// A bson.D keeps every field in the order the server stores them
+		var review bson.D
+		if err := coll.FindOne(context.TODO(), filter).Decode(&review); err != nil {
+			panic(err)
+		}
+		for _, elem := range review {
+			fmt.Printf("%s: %v\n", elem.Key, elem.Value)
+		}
+		// end decode ordered
+	}
+}
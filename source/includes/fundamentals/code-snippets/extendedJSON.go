@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// start-order-struct
+type Order struct {
+	ID          primitive.ObjectID   `bson:"_id"`
+	Item        string               `bson:"item"`
+	Price       primitive.Decimal128 `bson:"price"`
+	DateOrdered time.Time            `bson:"date_ordered"`
+}
+
+// end-order-struct
+
+func main() {
+	price, err := primitive.ParseDecimal128("19.99")
+	if err != nil {
+		panic(err)
+	}
+	order := Order{
+		ID:          primitive.NewObjectID(),
+		Item:        "Masala",
+		Price:       price,
+		DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	fmt.Println("\nCanonical and Relaxed Extended JSON:\n")
+	{
+		// begin marshal
+		// Canonical mode preserves every BSON type, so numbers and dates
+		// are wrapped in type objects such as $numberDecimal and
+		// $date.$numberLong. Relaxed mode writes int32, int64, and double
+		// values as native JSON numbers and dates as ISO-8601 strings,
+		// which is easier to read but doesn't preserve all type
+		// information. A Decimal128 value such as the price stays wrapped
+		// in $numberDecimal in both modes.
+		canonical, err := bson.MarshalExtJSON(order, true, false)
+		if err != nil {
+			panic(err)
+		}
+		relaxed, err := bson.MarshalExtJSON(order, false, false)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Canonical: %s\n", canonical)
+		fmt.Printf("Relaxed: %s\n", relaxed)
+		// end marshal
+	}
+
+	fmt.Println("\nUnmarshal Extended JSON:\n")
+	{
+		// begin unmarshal
+		// Output from tools like mongoexport uses the same format
+		input := `{"_id": {"$oid": "65170b42b99efdd0b07d42de"}, "item": "Sencha", "price": {"$numberDecimal": "7.25"}, "date_ordered": {"$date": {"$numberLong": "1258502400000"}}}`
+
+		var result Order
+		if err := bson.UnmarshalExtJSON([]byte(input), true, &result); err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("ID: %s\nItem: %s\nPrice: %s\nDate ordered: %s\n",
+			result.ID.Hex(), result.Item, result.Price, result.DateOrdered.UTC().Format(time.RFC3339))
+		// end unmarshal
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-place-struct
+type Point struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+type Place struct {
+	Name     string `bson:"name"`
+	Location Point  `bson:"location"`
+}
+
+// end-place-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("shops").Drop(context.TODO())
+
+	// begin insert docs
+	// GeoJSON coordinates list longitude first, then latitude
+	coll := client.Database("tea").Collection("shops")
+	docs := []interface{}{
+		Place{Name: "Midtown Tea Bar", Location: Point{Type: "Point", Coordinates: []float64{-73.9857, 40.7484}}},
+		Place{Name: "Chelsea Leaf", Location: Point{Type: "Point", Coordinates: []float64{-74.0014, 40.7465}}},
+		Place{Name: "Harlem Brew House", Location: Point{Type: "Point", Coordinates: []float64{-73.9465, 40.8116}}},
+		Place{Name: "Brooklyn Steep", Location: Point{Type: "Point", Coordinates: []float64{-73.9442, 40.6782}}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin 2dsphere index
+	indexModel := mongo.IndexModel{Keys: bson.D{{"location", "2dsphere"}}}
+
+	name, err := coll.Indexes().CreateOne(context.TODO(), indexModel)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Name of index created: " + name)
+	// end 2dsphere index
+
+	fmt.Println("\nNear Query:\n")
+	{
+		// begin near query
+		// The $near operator sorts the results from nearest to farthest.
+		// $maxDistance is in meters.
+		point := Point{Type: "Point", Coordinates: []float64{-73.986805, 40.7620853}}
+		filter := bson.D{
+			{"location", bson.D{
+				{"$near", bson.D{
+					{"$geometry", point},
+					{"$maxDistance", 5000},
+				}},
+			}},
+		}
+
+		cursor, err := coll.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Place
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end near query
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-place-struct
+type Point struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+type Place struct {
+	Name     string `bson:"name"`
+	Location Point  `bson:"location"`
+}
+
+// end-place-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("shops").Drop(context.TODO())
+
+	// begin insert docs
+	// GeoJSON coordinates list longitude first, then latitude
+	coll := client.Database("tea").Collection("shops")
+	docs := []interface{}{
+		Place{Name: "Midtown Tea Bar", Location: Point{Type: "Point", Coordinates: []float64{-73.9857, 40.7484}}},
+		Place{Name: "Chelsea Leaf", Location: Point{Type: "Point", Coordinates: []float64{-74.0014, 40.7465}}},
+		Place{Name: "Harlem Brew House", Location: Point{Type: "Point", Coordinates: []float64{-73.9465, 40.8116}}},
+		Place{Name: "Brooklyn Steep", Location: Point{Type: "Point", Coordinates: []float64{-73.9442, 40.6782}}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nWithin Polygon Query:\n")
+	{
+		// begin geowithin query
+		// The polygon's first and last positions are the same, which
+		// closes the shape. Unlike $near, $geoWithin doesn't require a
+		// geospatial index or sort the results.
+		polygon := bson.D{
+			{"type", "Polygon"},
+			{"coordinates", [][][]float64{{
+				{-74.02, 40.70},
+				{-73.93, 40.70},
+				{-73.93, 40.80},
+				{-74.02, 40.80},
+				{-74.02, 40.70},
+			}}},
+		}
+		filter := bson.D{{"location", bson.D{{"$geoWithin", bson.D{{"$geometry", polygon}}}}}}
+
+		cursor, err := coll.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Place
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			fmt.Printf("%s is inside the polygon\n", result.Name)
+		}
+		// end geowithin query
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	bucket, err := gridfs.NewBucket(client.Database("tea"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Upload two revisions of the same file, as in the gridfsUpload.go
+	// example, so there is something to download
+	uploadOpts := options.GridFSUpload().
+		SetMetadata(bson.D{{"contentType", "image/png"}})
+	if _, err := bucket.UploadFromStream("teapot.png", bytes.NewReader([]byte("first revision")), uploadOpts); err != nil {
+		panic(err)
+	}
+	id, err := bucket.UploadFromStream("teapot.png", bytes.NewReader([]byte("second revision")), uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("\nDownload by ID:\n")
+	{
+		// begin DownloadToStream example
+		fileBuffer := bytes.NewBuffer(nil)
+
+		bytesWritten, err := bucket.DownloadToStream(id, fileBuffer)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Downloaded %d bytes: %s\n", bytesWritten, fileBuffer.String())
+		// end DownloadToStream example
+	}
+
+	fmt.Println("\nDownload by Name:\n")
+	{
+		// begin DownloadToStreamByName example
+		file, err := os.Create("teapot.png")
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+
+		// A revision of -1 selects the most recently uploaded file with
+		// the given name, and 0 selects the original
+		opts := options.GridFSName().SetRevision(-1)
+
+		bytesWritten, err := bucket.DownloadToStreamByName("teapot.png", file, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Wrote %d bytes to %s\n", bytesWritten, file.Name())
+		// end DownloadToStreamByName example
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-file-struct
+type gridfsFile struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Name       string             `bson:"filename"`
+	Length     int64              `bson:"length"`
+	UploadDate time.Time          `bson:"uploadDate"`
+}
+
+// end-file-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	bucket, err := gridfs.NewBucket(client.Database("tea"))
+	if err != nil {
+		panic(err)
+	}
+	if err = bucket.Drop(); err != nil {
+		panic(err)
+	}
+
+	// begin upload files
+	pngOpts := options.GridFSUpload().SetMetadata(bson.D{{"contentType", "image/png"}})
+	for _, name := range []string{"teapot.png", "teacup.png", "kettle.png"} {
+		if _, err := bucket.UploadFromStream(name, bytes.NewReader([]byte("image content for "+name)), pngOpts); err != nil {
+			panic(err)
+		}
+	}
+	// end upload files
+
+	fmt.Println("\nFind Files:\n")
+	var foundFiles []gridfsFile
+	{
+		// begin find files
+		// Sorting by filename makes the order of the results predictable
+		filter := bson.D{{"metadata.contentType", "image/png"}}
+		opts := options.GridFSFind().SetSort(bson.D{{"filename", 1}})
+		cursor, err := bucket.Find(filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		if err = cursor.All(context.TODO(), &foundFiles); err != nil {
+			panic(err)
+		}
+		for _, file := range foundFiles {
+			fmt.Printf("filename: %s, length: %d, uploaded: %s\n", file.Name, file.Length, file.UploadDate.Format(time.RFC3339))
+		}
+		// end find files
+	}
+
+	fmt.Println("\nDelete and Rename Files:\n")
+	{
+		// begin delete and rename
+		if err := bucket.Delete(foundFiles[0].ID); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Deleted %s\n", foundFiles[0].Name)
+
+		if err := bucket.Rename(foundFiles[1].ID, "teacup-blue.png"); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Renamed %s to teacup-blue.png\n", foundFiles[1].Name)
+		// end delete and rename
+	}
+
+	fmt.Println("\nFile Not Found:\n")
+	{
+		// begin file not found
+		// The file was already deleted, so the bucket can't find it
+		err := bucket.Delete(foundFiles[0].ID)
+		if err == gridfs.ErrFileNotFound {
+			fmt.Printf("No file exists with ID %s\n", foundFiles[0].ID.Hex())
+		} else if err != nil {
+			panic(err)
+		}
+		// end file not found
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	// begin create bucket
+	bucket, err := gridfs.NewBucket(client.Database("tea"))
+	if err != nil {
+		panic(err)
+	}
+	// end create bucket
+
+	// begin open source
+	// Read from a local file if one exists. Otherwise, upload a small
+	// in-memory file so the example runs without external files.
+	var source io.Reader
+	file, err := os.Open("path/to/teapot.png")
+	if err == nil {
+		defer file.Close()
+		source = file
+	} else {
+		source = bytes.NewReader([]byte("placeholder image content"))
+	}
+	// end open source
+
+	// begin UploadFromStream example
+	uploadOpts := options.GridFSUpload().
+		SetMetadata(bson.D{{"contentType", "image/png"}})
+
+	objectID, err := bucket.UploadFromStream("teapot.png", source, uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("New file uploaded with ID %s\n", objectID.Hex())
+	// end UploadFromStream example
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nTTL Index:\n")
+	{
+		// begin ttl index
+		// When the date_ordered value of a document is older than
+		// 3600 seconds, the document becomes eligible for deletion. This
+		// includes every sample document, since they were ordered in 2009.
+		indexModel := mongo.IndexModel{
+			Keys:    bson.D{{"date_ordered", 1}},
+			Options: options.Index().SetExpireAfterSeconds(3600),
+		}
+
+		name, err := coll.Indexes().CreateOne(context.TODO(), indexModel)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println("Name of index created: " + name)
+		// end ttl index
+	}
+
+	fmt.Println("\nInsert Expired Document:\n")
+	{
+		// begin insert expired
+		// A background task on the server removes expired documents
+		// roughly every 60 seconds, so this document isn't deleted
+		// immediately even though it's already past its expiration time
+		oldReview := Review{Item: "Oolong", Rating: 6, DateOrdered: time.Now().Add(-2 * time.Hour)}
+
+		result, err := coll.InsertOne(context.TODO(), oldReview)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Inserted document with _id: %v\n", result.InsertedID)
+		// end insert expired
+	}
+}
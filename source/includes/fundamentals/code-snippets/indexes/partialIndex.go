@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin partial index
+	// The index contains entries only for reviews with a rating of 8 or
+	// higher, so it's smaller and cheaper to maintain than an index on
+	// every review. The server uses it only for queries whose filter
+	// matches a subset of the partial filter expression.
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{{"rating", 1}},
+		Options: options.Index().
+			SetPartialFilterExpression(bson.D{{"rating", bson.D{{"$gte", 8}}}}),
+	}
+
+	name, err := coll.Indexes().CreateOne(context.TODO(), indexModel)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Name of index created: " + name)
+	// end partial index
+
+	fmt.Println("\nExplain Query:\n")
+	{
+		// begin explain partial
+		findCommand := bson.D{{"find", "reviews"}, {"filter", bson.D{{"rating", bson.D{{"$gte", 8}}}}}}
+		explainCommand := bson.D{{"explain", findCommand}, {"verbosity", "queryPlanner"}}
+
+		var result bson.M
+		err := client.Database("tea").RunCommand(context.TODO(), explainCommand).Decode(&result)
+		if err != nil {
+			panic(err)
+		}
+
+		// The winning plan contains an IXSCAN stage on the rating_1 index
+		queryPlanner := result["queryPlanner"].(bson.M)
+		output, err := json.MarshalIndent(queryPlanner["winningPlan"], "", "    ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s\n", output)
+		// end explain partial
+	}
+}
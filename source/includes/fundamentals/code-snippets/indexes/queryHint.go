@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin create indexes
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"item", 1}, {"rating", -1}},
+			Options: options.Index().SetName("item_rating_idx"),
+		},
+		{Keys: bson.D{{"rating", 1}}},
+	}
+
+	names, err := coll.Indexes().CreateMany(context.TODO(), indexModels)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Names of indexes created: %v\n", names)
+	// end create indexes
+
+	filter := bson.D{{"item", "Masala"}, {"rating", bson.D{{"$gte", 9}}}}
+
+	fmt.Println("\nHint by Name:\n")
+	{
+		// begin hint name
+		// The planner can satisfy this filter with either index. The hint
+		// skips plan selection and uses the named index.
+		opts := options.Find().SetHint("item_rating_idx")
+
+		cursor, err := coll.Find(context.TODO(), filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end hint name
+	}
+
+	fmt.Println("\nHint by Key Pattern:\n")
+	{
+		// begin hint keys
+		// A key pattern hint must match the keys of an existing index
+		// exactly. The server returns an error if no index matches.
+		opts := options.Find().SetHint(bson.D{{"rating", 1}})
+
+		cursor, err := coll.Find(context.TODO(), filter, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end hint keys
+	}
+
+	fmt.Println("\nExplain Hinted Query:\n")
+	{
+		// begin explain hint
+		findCommand := bson.D{{"find", "reviews"}, {"filter", filter}, {"hint", "item_rating_idx"}}
+		explainCommand := bson.D{{"explain", findCommand}, {"verbosity", "queryPlanner"}}
+
+		var result bson.M
+		err := client.Database("tea").RunCommand(context.TODO(), explainCommand).Decode(&result)
+		if err != nil {
+			panic(err)
+		}
+
+		// The winning plan contains an IXSCAN stage on item_rating_idx
+		queryPlanner := result["queryPlanner"].(bson.M)
+		output, err := json.MarshalIndent(queryPlanner["winningPlan"], "", "    ")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s\n", output)
+		// end explain hint
+	}
+}
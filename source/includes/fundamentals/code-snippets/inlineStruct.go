@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-inline-structs
+type Audit struct {
+	CreatedBy string    `bson:"created_by"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// The inline tag flattens the Audit fields into the review document
+type InlineReview struct {
+	Item   string `bson:"item"`
+	Rating int32  `bson:"rating"`
+	Audit  `bson:",inline"`
+}
+
+// Without the inline tag, the Audit fields are stored in an embedded
+// document under the audit field
+type NestedReview struct {
+	Item   string `bson:"item"`
+	Rating int32  `bson:"rating"`
+	Audit
+}
+
+// end-inline-structs
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	audit := Audit{CreatedBy: "Sam", CreatedAt: time.Date(2009, 11, 17, 0, 0, 0, 0, time.UTC)}
+	docs := []interface{}{
+		InlineReview{Item: "Masala", Rating: 10, Audit: audit},
+		NestedReview{Item: "Sencha", Rating: 7, Audit: audit},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nStored Documents:\n")
+	{
+		// begin print stored
+		opts := options.Find().SetProjection(bson.D{{"_id", 0}})
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		// Decoding into bson.D keeps the fields in their stored order
+		var results []bson.D
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end print stored
+	}
+}
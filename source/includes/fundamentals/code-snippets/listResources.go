@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin create view
+	db := client.Database("tea")
+	db.Collection("high_ratings").Drop(context.TODO())
+
+	highRatingsStage := bson.D{{"$match", bson.D{{"rating", bson.D{{"$gte", 9}}}}}}
+	err = db.CreateView(context.TODO(), "high_ratings", "reviews", mongo.Pipeline{highRatingsStage})
+	if err != nil {
+		panic(err)
+	}
+	// end create view
+
+	fmt.Println("\nList Databases:\n")
+	{
+		// begin list databases
+		dbNames, err := client.ListDatabaseNames(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		for _, name := range dbNames {
+			fmt.Println(name)
+		}
+		// end list databases
+	}
+
+	fmt.Println("\nList Collection Names:\n")
+	{
+		// begin list collection names
+		collNames, err := db.ListCollectionNames(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		for _, name := range collNames {
+			fmt.Println(name)
+		}
+		// end list collection names
+	}
+
+	fmt.Println("\nList Collection Specifications:\n")
+	{
+		// begin list collection specifications
+		specs, err := db.ListCollectionSpecifications(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+
+		// The Type field is "collection" or "view", and the Options field
+		// contains the options the collection or view was created with,
+		// such as the source collection and pipeline of a view
+		for _, spec := range specs {
+			fmt.Printf("name: %s, type: %s, options: %s\n", spec.Name, spec.Type, spec.Options)
+		}
+		// end list collection specifications
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"sync"
 
@@ -28,6 +29,7 @@ func main() {
 	//standardLogging(uri)
 	//customLogging(uri)
 	thirdPartyLogging(uri)
+	//slogLogging(uri)
 }
 
 func standardLogging(uri string) {
@@ -178,3 +180,58 @@ func thirdPartyLogging(uri string) {
 	}
 	// end-log-operations
 }
+
+// start-slogsink-struct
+type SlogSink struct {
+	logger *slog.Logger
+}
+
+// end-slogsink-struct
+
+// start-slogsink-funcs
+func (sink *SlogSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	slogLevel := slog.LevelInfo
+	if options.LogLevel(level+1) == options.LogLevelDebug {
+		slogLevel = slog.LevelDebug
+	}
+	sink.logger.Log(context.Background(), slogLevel, msg, keysAndValues...)
+}
+
+func (sink *SlogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	sink.logger.Error(msg, append(keysAndValues, "error", err)...)
+}
+
+// end-slogsink-funcs
+
+func slogLogging(uri string) {
+	// start-set-slogsink
+	handler := slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+	sink := &SlogSink{logger: slog.New(handler)}
+
+	loggerOptions := options.
+		Logger().
+		SetSink(sink).
+		SetComponentLevel(options.LogComponentCommand, options.LogLevelDebug)
+
+	clientOptions := options.
+		Client().
+		ApplyURI(uri).
+		SetLoggerOptions(loggerOptions)
+	// end-set-slogsink
+	client, err := mongo.Connect(context.TODO(), clientOptions)
+	if err != nil {
+		panic(err)
+	}
+
+	defer client.Disconnect(context.TODO())
+
+	type Item struct {
+		Name string
+	}
+
+	coll := client.Database("testDB").Collection("testColl")
+	_, err = coll.InsertOne(context.TODO(), Item{Name: "passion fruit"})
+	if err != nil {
+		panic(err)
+	}
+}
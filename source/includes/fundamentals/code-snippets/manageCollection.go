@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	db := client.Database("tea")
+	db.Collection("reviews_archive").Drop(context.TODO())
+
+	fmt.Println("\nCheck Collection Exists:\n")
+	{
+		// begin check exists
+		names, err := db.ListCollectionNames(context.TODO(), bson.D{{"name", "reviews"}})
+		if err != nil {
+			panic(err)
+		}
+
+		if len(names) == 0 {
+			fmt.Println("The reviews collection does not exist")
+			return
+		}
+		fmt.Println("The reviews collection exists")
+		// end check exists
+	}
+
+	fmt.Println("\nRename Collection:\n")
+	{
+		// begin rename collection
+		// The renameCollection command must run on the admin database and
+		// takes the full namespace of the source and target collections
+		renameCommand := bson.D{
+			{"renameCollection", "tea.reviews"},
+			{"to", "tea.reviews_archive"},
+		}
+
+		var result bson.M
+		err := client.Database("admin").RunCommand(context.TODO(), renameCommand).Decode(&result)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println("Renamed tea.reviews to tea.reviews_archive")
+		// end rename collection
+	}
+
+	fmt.Println("\nDrop Collection:\n")
+	{
+		// begin drop collection
+		archiveColl := db.Collection("reviews_archive")
+		if err := archiveColl.Drop(context.TODO()); err != nil {
+			panic(err)
+		}
+		fmt.Println("Dropped tea.reviews_archive")
+		// end drop collection
+
+		names, err := db.ListCollectionNames(context.TODO(), bson.D{{"name", "reviews_archive"}})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Collections named reviews_archive: %d\n", len(names))
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-omitempty-structs
+// The omitempty option skips the rating field when it holds the zero
+// value for its type, which is 0 for an int32
+type OmitReview struct {
+	Item   string `bson:"item"`
+	Rating int32  `bson:"rating,omitempty"`
+}
+
+// Without omitempty, the driver always writes the rating field
+type AlwaysReview struct {
+	Item   string `bson:"item"`
+	Rating int32  `bson:"rating"`
+}
+
+// end-omitempty-structs
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	// Neither review has a rating yet
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		OmitReview{Item: "Masala"},
+		AlwaysReview{Item: "Sencha"},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nStored Documents:\n")
+	{
+		// begin print stored
+		opts := options.Find().SetProjection(bson.D{{"_id", 0}})
+		cursor, err := coll.Find(context.TODO(), bson.D{}, opts)
+		if err != nil {
+			panic(err)
+		}
+
+		// The Masala document has no rating field, and the Sencha
+		// document has a rating of 0
+		var results []bson.D
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := bson.MarshalExtJSON(result, false, false)
+			fmt.Println(string(res))
+		}
+		// end print stored
+	}
+}
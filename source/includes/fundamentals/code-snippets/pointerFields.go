@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+// A pointer field is nil when the rating is null or missing, so a
+// rating of 0 is no longer confused with no rating
+type Review struct {
+	Item   string `bson:"item"`
+	Rating *int32 `bson:"rating"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		bson.D{{"item", "Masala"}, {"rating", 8}},
+		bson.D{{"item", "Sencha"}, {"rating", 0}},
+		bson.D{{"item", "Hibiscus"}, {"rating", nil}},
+		bson.D{{"item", "Oolong"}},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nDecode Pointer Fields:\n")
+	{
+		// begin decode pointer
+		cursor, err := coll.Find(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		defer cursor.Close(context.TODO())
+
+		for cursor.Next(context.TODO()) {
+			var review Review
+			if err := cursor.Decode(&review); err != nil {
+				panic(err)
+			}
+
+			if review.Rating != nil {
+				fmt.Printf("%s: rating is %d\n", review.Item, *review.Rating)
+				continue
+			}
+
+			// The pointer is nil for both a null and a missing rating.
+			// To tell them apart, look up the field in the raw document.
+			value, err := cursor.Current.LookupErr("rating")
+			switch {
+			case err == nil && value.Type == bsontype.Null:
+				fmt.Printf("%s: rating is null\n", review.Item)
+			case err != nil:
+				fmt.Printf("%s: rating is missing\n", review.Item)
+			}
+		}
+		if err := cursor.Err(); err != nil {
+			panic(err)
+		}
+		// end decode pointer
+	}
+}
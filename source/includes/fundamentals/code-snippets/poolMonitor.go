@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin pool monitor
+	var mu sync.Mutex
+	counts := make(map[string]int)
+
+	poolMonitor := &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			counts[e.Type]++
+		},
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetPoolMonitor(poolMonitor)
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	// end pool monitor
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nConcurrent Operations:\n")
+	{
+		// begin burst
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				var result Review
+				if err := coll.FindOne(context.TODO(), bson.D{{"item", "Masala"}}).Decode(&result); err != nil {
+					log.Println(err)
+				}
+			}()
+		}
+		wg.Wait()
+		// end burst
+	}
+
+	fmt.Println("\nPool Event Counts:\n")
+	{
+		// begin print counts
+		// Failed checkouts, or a created count that reaches the maximum
+		// pool size, indicate that the workload is exhausting the pool
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Printf("Connections created: %d\n", counts[event.ConnectionCreated])
+		fmt.Printf("Connections checked out: %d\n", counts[event.GetSucceeded])
+		fmt.Printf("Connections returned: %d\n", counts[event.ConnectionReturned])
+		fmt.Printf("Failed checkouts: %d\n", counts[event.GetFailed])
+		// end print counts
+	}
+}
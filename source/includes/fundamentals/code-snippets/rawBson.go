@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nLookup a Single Field:\n")
+	{
+		// begin raw lookup
+		// Decoding into bson.Raw copies the document bytes without
+		// unmarshaling them, and Lookup() reads only the requested field
+		var raw bson.Raw
+		err := coll.FindOne(context.TODO(), bson.D{{"item", "Sencha"}}).Decode(&raw)
+		if err != nil {
+			panic(err)
+		}
+
+		rating := raw.Lookup("rating").Int32()
+		fmt.Printf("Rating: %d\n", rating)
+		// end raw lookup
+	}
+
+	fmt.Println("\nIterate Elements:\n")
+	{
+		// begin raw elements
+		var raw bson.Raw
+		err := coll.FindOne(context.TODO(), bson.D{{"item", "Hibiscus"}}).Decode(&raw)
+		if err != nil {
+			panic(err)
+		}
+
+		elements, err := raw.Elements()
+		if err != nil {
+			panic(err)
+		}
+		for _, element := range elements {
+			fmt.Printf("%s (%s): %s\n", element.Key(), element.Value().Type, element.Value())
+		}
+		// end raw elements
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nMajority Read Concern:\n")
+	{
+		// begin majority read concern
+		// A majority read concern returns only data that most replica
+		// set members have acknowledged, so the results can't be rolled
+		// back. They might not include the most recent writes.
+		rc := readconcern.Majority()
+		dbOpts := options.Database().SetReadConcern(rc)
+		majorityColl := client.Database("tea", dbOpts).Collection("reviews")
+
+		cursor, err := majorityColl.Find(context.TODO(), bson.D{{"item", "Masala"}})
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+		// end majority read concern
+	}
+
+	fmt.Println("\nSnapshot Read Concern:\n")
+	{
+		// begin snapshot read concern
+		// A snapshot read concern makes every read in the transaction see
+		// majority-committed data from the same point in time
+		txnOpts := options.Transaction().SetReadConcern(readconcern.Snapshot())
+
+		session, err := client.StartSession()
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		results, err := session.WithTransaction(context.TODO(), func(ctx mongo.SessionContext) (interface{}, error) {
+			cursor, err := coll.Find(ctx, bson.D{{"item", "Sencha"}})
+			if err != nil {
+				return nil, err
+			}
+
+			var results []Review
+			if err = cursor.All(ctx, &results); err != nil {
+				return nil, err
+			}
+			return results, nil
+		}, txnOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, result := range results.([]Review) {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+		// end snapshot read concern
+	}
+}
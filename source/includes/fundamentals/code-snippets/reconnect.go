@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-with-retry
+// withRetry runs op up to maxAttempts times. After a network error, it
+// waits before the next attempt, doubling the wait each time up to a
+// limit. Other errors are returned right away, because retrying won't
+// fix them. maxAttempts must be at least 1.
+func withRetry(maxAttempts int, op func() error) error {
+	const (
+		baseDelay = 100 * time.Millisecond
+		maxDelay  = 5 * time.Second
+	)
+
+	if maxAttempts < 1 {
+		return fmt.Errorf("maxAttempts must be at least 1, got %d", maxAttempts)
+	}
+
+	var err error
+	backoff := baseDelay
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = op()
+		if err == nil {
+			fmt.Printf("Attempt %d succeeded\n", attempt)
+			return nil
+		}
+		if !mongo.IsNetworkError(err) {
+			return err
+		}
+		if attempt == maxAttempts {
+			fmt.Printf("Attempt %d failed with a network error: %v\n", attempt, err)
+			break
+		}
+
+		// Waiting a random time up to the backoff keeps many clients that
+		// failed together from retrying at the same moment
+		var delay time.Duration
+		if backoff > 0 {
+			delay = time.Duration(rand.Int63n(int64(backoff)))
+		}
+
+		fmt.Printf("Attempt %d failed with a network error, retrying in %v: %v\n", attempt, delay.Round(time.Millisecond), err)
+		time.Sleep(delay)
+
+		// Stop doubling once the backoff reaches the limit, so it can't
+		// overflow after many attempts
+		if backoff < maxDelay {
+			backoff *= 2
+			if backoff > maxDelay {
+				backoff = maxDelay
+			}
+		}
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", maxAttempts, err)
+}
+
+// end-with-retry
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nRetry with Backoff:\n")
+	{
+		// begin retry operation
+		newReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+
+		err := withRetry(5, func() error {
+			_, err := coll.InsertOne(context.TODO(), newReview)
+			return err
+		})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println("Inserted the Oolong review")
+		// end retry operation
+	}
+}
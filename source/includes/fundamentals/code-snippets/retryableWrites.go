@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin enable retryable writes
+	// Retryable writes are on by default, so this setting only makes
+	// the behavior explicit. The driver retries a write once after a
+	// network error or a server error such as a primary stepping down.
+	opts := options.Client().
+		ApplyURI(uri).
+		SetRetryWrites(true)
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	// end enable retryable writes
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nRetryable Write:\n")
+	{
+		// begin retryable write
+		// The driver retries single-document writes such as InsertOne(),
+		// UpdateOne(), ReplaceOne(), DeleteOne(), and the FindOneAnd*()
+		// methods, and bulk writes that don't contain multi-document
+		// updates or deletes. UpdateMany() and DeleteMany() aren't
+		// retried.
+		//
+		// To simulate a transient error on a test deployment, you can
+		// make the server fail the next update with a retryable error
+		// code before running this example:
+		//
+		// failPoint := bson.D{
+		// 	{"configureFailPoint", "failCommand"},
+		// 	{"mode", bson.D{{"times", 1}}},
+		// 	{"data", bson.D{
+		// 		{"failCommands", bson.A{"update"}},
+		// 		{"errorCode", 91},
+		// 	}},
+		// }
+		// client.Database("admin").RunCommand(context.TODO(), failPoint)
+		//
+		// The first attempt then fails with a ShutdownInProgress error, and
+		// the driver's automatic retry succeeds.
+		filter := bson.D{{"item", "Hibiscus"}}
+		update := bson.D{{"$set", bson.D{{"rating", 5}}}}
+
+		result, err := coll.UpdateOne(context.TODO(), filter, update)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Documents updated: %v\n", result.ModifiedCount)
+		// end retryable write
+	}
+
+	fmt.Println("\nDisable Retryable Writes:\n")
+	{
+		// begin disable retryable writes
+		// Without retries, the driver returns the first transient error
+		// to your application, which must decide whether to retry
+		noRetryOpts := options.Client().
+			ApplyURI(uri).
+			SetRetryWrites(false)
+
+		noRetryClient, err := mongo.Connect(context.TODO(), noRetryOpts)
+		if err != nil {
+			panic(err)
+		}
+		defer noRetryClient.Disconnect(context.TODO())
+
+		noRetryColl := noRetryClient.Database("tea").Collection("reviews")
+		result, err := noRetryColl.UpdateOne(context.TODO(), bson.D{{"item", "Hibiscus"}}, bson.D{{"$inc", bson.D{{"rating", 1}}}})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Documents updated: %v\n", result.ModifiedCount)
+		// end disable retryable writes
+	}
+}
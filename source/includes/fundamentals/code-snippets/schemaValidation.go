@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	db := client.Database("tea")
+	db.Collection("ratings").Drop(context.TODO())
+
+	fmt.Println("\nCreate Collection with Validation:\n")
+	{
+		// begin create validated collection
+		// Every document must have a string item field and an integer
+		// rating field between 1 and 10
+		schema := bson.D{
+			{"bsonType", "object"},
+			{"required", bson.A{"item", "rating"}},
+			{"properties", bson.D{
+				{"item", bson.D{
+					{"bsonType", "string"},
+					{"description", "must be a string"},
+				}},
+				{"rating", bson.D{
+					{"bsonType", "int"},
+					{"minimum", 1},
+					{"maximum", 10},
+					{"description", "must be an integer between 1 and 10"},
+				}},
+			}},
+		}
+
+		opts := options.CreateCollection().
+			SetValidator(bson.D{{"$jsonSchema", schema}}).
+			SetValidationLevel("strict")
+
+		if err := db.CreateCollection(context.TODO(), "ratings", opts); err != nil {
+			panic(err)
+		}
+		fmt.Println("Created the ratings collection")
+		// end create validated collection
+	}
+
+	coll := db.Collection("ratings")
+
+	fmt.Println("\nInsert Valid Document:\n")
+	{
+		// begin insert valid
+		doc := bson.D{{"item", "Masala"}, {"rating", 9}}
+		result, err := coll.InsertOne(context.TODO(), doc)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Inserted document with _id: %v\n", result.InsertedID)
+		// end insert valid
+	}
+
+	fmt.Println("\nInsert Invalid Document:\n")
+	{
+		// begin insert invalid
+		// The rating is greater than the schema maximum
+		doc := bson.D{{"item", "Sencha"}, {"rating", 15}}
+		_, err := coll.InsertOne(context.TODO(), doc)
+
+		var writeException mongo.WriteException
+		if errors.As(err, &writeException) {
+			for _, writeErr := range writeException.WriteErrors {
+				// Code 121 means the document failed validation, and the
+				// Details field describes which schema rules it broke
+				fmt.Printf("code: %d, message: %s\n", writeErr.Code, writeErr.Message)
+				fmt.Printf("details: %s\n", writeErr.Details)
+			}
+		} else if err != nil {
+			panic(err)
+		}
+		// end insert invalid
+	}
+}
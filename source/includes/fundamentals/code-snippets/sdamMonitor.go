@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin server monitor
+	// A primary step-down appears as a server changing from RSPrimary to
+	// RSSecondary, followed by another server changing to RSPrimary. A
+	// newly added secondary appears in the topology's server list.
+	srvMonitor := &event.ServerMonitor{
+		ServerDescriptionChanged: func(e *event.ServerDescriptionChangedEvent) {
+			log.Printf("server %s changed from %s to %s\n",
+				e.Address, e.PreviousDescription.Kind, e.NewDescription.Kind)
+		},
+		TopologyDescriptionChanged: func(e *event.TopologyDescriptionChangedEvent) {
+			log.Printf("topology changed from %s to %s\n",
+				e.PreviousDescription.Kind, e.NewDescription.Kind)
+			for _, server := range e.NewDescription.Servers {
+				log.Printf("\t%s: %s\n", server.Addr, server.Kind)
+			}
+		},
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetServerMonitor(srvMonitor)
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	// end server monitor
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	// The driver monitors the deployment in the background, so keep the
+	// client open long enough to observe changes, such as a failover
+	// triggered by running rs.stepDown() in the shell
+	time.Sleep(2 * time.Minute)
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-classify
+// classify prints which error categories the driver's helper functions
+// report for err. An error can belong to more than one category.
+func classify(err error) {
+	if err == nil {
+		fmt.Println("No error")
+		return
+	}
+
+	fmt.Printf("Error: %v\n", err)
+	fmt.Printf("mongo.IsDuplicateKeyError: %v\n", mongo.IsDuplicateKeyError(err))
+	fmt.Printf("mongo.IsTimeout: %v\n", mongo.IsTimeout(err))
+	fmt.Printf("mongo.IsNetworkError: %v\n", mongo.IsNetworkError(err))
+
+	// Server errors also carry a numeric code
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		fmt.Printf("Has code 11000: %v\n", serverErr.HasErrorCode(11000))
+		fmt.Printf("Has code 50: %v\n", serverErr.HasErrorCode(50))
+	}
+}
+
+// end-classify
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nDuplicate Key Error:\n")
+	{
+		// begin duplicate key
+		duplicate := bson.D{{"_id", result.InsertedIDs[0]}, {"item", "Masala"}, {"rating", 5}}
+		_, err := coll.InsertOne(context.TODO(), duplicate)
+		classify(err)
+		// end duplicate key
+	}
+
+	// The $where expression sleeps for 50 milliseconds on each document,
+	// so any query that uses it takes about 300 milliseconds. This
+	// requires server-side JavaScript to be enabled.
+	slowFilter := bson.D{{"$where", "sleep(50) || true"}}
+
+	fmt.Println("\nServer Timeout:\n")
+	{
+		// begin server timeout
+		// The server stops the query once it exceeds maxTimeMS and
+		// returns an error
+		opts := options.Find().SetMaxTime(100 * time.Millisecond)
+		cursor, err := coll.Find(context.TODO(), slowFilter, opts)
+		if err == nil {
+			var results []Review
+			err = cursor.All(context.TODO(), &results)
+		}
+		classify(err)
+		// end server timeout
+	}
+
+	fmt.Println("\nSocket Timeout:\n")
+	{
+		// begin socket timeout
+		// The driver closes the connection when a response takes longer
+		// than the socket timeout, so the error is both a timeout and a
+		// network error
+		socketOpts := options.Client().
+			ApplyURI(uri).
+			SetSocketTimeout(100 * time.Millisecond)
+
+		socketClient, err := mongo.Connect(context.TODO(), socketOpts)
+		if err != nil {
+			panic(err)
+		}
+		defer socketClient.Disconnect(context.TODO())
+
+		socketColl := socketClient.Database("tea").Collection("reviews")
+		cursor, err := socketColl.Find(context.TODO(), slowFilter)
+		if err == nil {
+			var results []Review
+			err = cursor.All(context.TODO(), &results)
+		}
+		classify(err)
+		// end socket timeout
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+// start-stock-struct
+type Stock struct {
+	Item     string `bson:"item"`
+	Quantity int32  `bson:"quantity"`
+}
+
+// end-stock-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin insert stock
+	stockColl := client.Database("tea").Collection("stock")
+	stockColl.Drop(context.TODO())
+
+	stock := []interface{}{
+		Stock{Item: "Masala", Quantity: 40},
+		Stock{Item: "Sencha", Quantity: 25},
+		Stock{Item: "Hibiscus", Quantity: 12},
+	}
+	if _, err := stockColl.InsertMany(context.TODO(), stock); err != nil {
+		panic(err)
+	}
+	// end insert stock
+
+	fmt.Println("\nSnapshot Reads:\n")
+	{
+		// begin snapshot reads
+		// The server picks the snapshot at the first read in the
+		// transaction. Every later read in the transaction sees the data
+		// as of that point, even if other clients write in the meantime.
+		txnOpts := options.Transaction().SetReadConcern(readconcern.Snapshot())
+
+		session, err := client.StartSession()
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		_, err = session.WithTransaction(context.TODO(), func(sc mongo.SessionContext) (interface{}, error) {
+			reviewCount, err := coll.CountDocuments(sc, bson.D{})
+			if err != nil {
+				return nil, err
+			}
+			fmt.Printf("Reviews in snapshot: %d\n", reviewCount)
+
+			// These writes use context.TODO() instead of the session
+			// context, so they run outside the transaction like writes
+			// from another client
+			newReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+			if _, err := coll.InsertOne(context.TODO(), newReview); err != nil {
+				return nil, err
+			}
+			_, err = stockColl.UpdateOne(context.TODO(), bson.D{{"item", "Masala"}}, bson.D{{"$inc", bson.D{{"quantity", -10}}}})
+			if err != nil {
+				return nil, err
+			}
+
+			// Neither read reflects the concurrent writes
+			reviewCount, err = coll.CountDocuments(sc, bson.D{})
+			if err != nil {
+				return nil, err
+			}
+			fmt.Printf("Reviews in snapshot after concurrent insert: %d\n", reviewCount)
+
+			cursor, err := stockColl.Find(sc, bson.D{})
+			if err != nil {
+				return nil, err
+			}
+			var results []Stock
+			if err = cursor.All(sc, &results); err != nil {
+				return nil, err
+			}
+			for _, result := range results {
+				fmt.Printf("Stock in snapshot: %s %d\n", result.Item, result.Quantity)
+			}
+
+			return nil, nil
+		}, txnOpts)
+		if err != nil {
+			panic(err)
+		}
+		// end snapshot reads
+	}
+
+	fmt.Println("\nCurrent Data:\n")
+	{
+		reviewCount, err := coll.CountDocuments(context.TODO(), bson.D{})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("Reviews: %d\n", reviewCount)
+
+		var masala Stock
+		if err := stockColl.FindOne(context.TODO(), bson.D{{"item", "Masala"}}).Decode(&masala); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Stock: %s %d\n", masala.Item, masala.Quantity)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -54,4 +55,64 @@ func main() {
 	}
 	fmt.Printf("%s\n", output)
 	// end check ts coll
+
+	client.Database("spring_weather").Collection("readings").Drop(context.TODO())
+
+	// begin create sensor ts coll
+	sensorTSO := options.TimeSeries().
+		SetTimeField("timestamp").
+		SetMetaField("sensor").
+		SetGranularity("seconds")
+	sensorOpts := options.CreateCollection().SetTimeSeriesOptions(sensorTSO)
+
+	if err := db.CreateCollection(context.TODO(), "readings", sensorOpts); err != nil {
+		panic(err)
+	}
+	// end create sensor ts coll
+
+	// begin insert readings
+	readings := db.Collection("readings")
+
+	start := time.Date(2022, 3, 1, 9, 0, 0, 0, time.UTC)
+	var docs []interface{}
+	for i := 0; i < 12; i++ {
+		for _, sensor := range []string{"greenhouse", "orchard"} {
+			docs = append(docs, bson.D{
+				{"timestamp", start.Add(time.Duration(i) * 15 * time.Minute)},
+				{"sensor", sensor},
+				{"temperature", 10.5 + float64(i)*0.5},
+			})
+		}
+	}
+
+	if _, err := readings.InsertMany(context.TODO(), docs); err != nil {
+		panic(err)
+	}
+	// end insert readings
+
+	// begin hourly averages
+	groupStage := bson.D{
+		{"$group", bson.D{
+			{"_id", bson.D{
+				{"sensor", "$sensor"},
+				{"hour", bson.D{{"$dateTrunc", bson.D{{"date", "$timestamp"}, {"unit", "hour"}}}}},
+			}},
+			{"average_temperature", bson.D{{"$avg", "$temperature"}}},
+		}}}
+	sortStage := bson.D{{"$sort", bson.D{{"_id.sensor", 1}, {"_id.hour", 1}}}}
+
+	cursor, err := readings.Aggregate(context.TODO(), mongo.Pipeline{groupStage, sortStage})
+	if err != nil {
+		panic(err)
+	}
+
+	var results []bson.M
+	if err = cursor.All(context.TODO(), &results); err != nil {
+		panic(err)
+	}
+	for _, result := range results {
+		id := result["_id"].(bson.M)
+		fmt.Printf("%v at %v: %v\n", id["sensor"], id["hour"], result["average_temperature"])
+	}
+	// end hourly averages
 }
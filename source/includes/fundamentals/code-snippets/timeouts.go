@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func main() {
+	// This address is reserved for documentation and never responds, so
+	// every operation on this client times out
+	uri := "mongodb://192.0.2.1:27017"
+
+	// start-timeout-options
+	opts := options.Client().
+		ApplyURI(uri).
+		// How long to wait for a suitable server before failing
+		SetServerSelectionTimeout(5 * time.Second).
+		// How long to wait for a new connection to be established
+		SetConnectTimeout(10 * time.Second).
+		// Limit on the total time of each operation, including server
+		// selection, connecting, and retries
+		SetTimeout(10 * time.Second)
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	// end-timeout-options
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(context.TODO())
+
+	coll := client.Database("tea").Collection("reviews")
+
+	fmt.Println("\nClient Timeout:\n")
+	{
+		// start-client-timeout
+		// With no deadline on the context, the operation fails once
+		// server selection exceeds its 5 second limit
+		start := time.Now()
+		_, err := coll.InsertOne(context.TODO(), bson.D{{"item", "Masala"}, {"rating", 10}})
+
+		fmt.Printf("Operation failed after %v\n", time.Since(start).Round(time.Second))
+		fmt.Printf("mongo.IsTimeout: %v\n", mongo.IsTimeout(err))
+		fmt.Printf("Error: %v\n", err)
+		// end-client-timeout
+	}
+
+	fmt.Println("\nContext Deadline:\n")
+	{
+		// start-context-deadline
+		// When the context has a deadline, the driver uses it in place
+		// of the client-level timeout
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := coll.InsertOne(ctx, bson.D{{"item", "Sencha"}, {"rating", 7}})
+
+		fmt.Printf("Operation failed after %v\n", time.Since(start).Round(time.Second))
+		fmt.Printf("context.DeadlineExceeded: %v\n", errors.Is(err, context.DeadlineExceeded))
+		fmt.Printf("mongo.IsTimeout: %v\n", mongo.IsTimeout(err))
+		// end-context-deadline
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+	//uriTLS()
+	configTLS(uri)
+}
+
+func uriTLS() {
+	// start-uri-tls
+	// Replace the placeholders with your deployment's hostname and port.
+	// Connection strings that use the mongodb+srv scheme, such as Atlas
+	// connection strings, enable TLS by default.
+	uri := "mongodb://<hostname>:<port>/?tls=true"
+	clientOpts := options.Client().ApplyURI(uri)
+	// end-uri-tls
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(context.TODO())
+
+	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+		panic(err)
+	}
+	fmt.Println("Connected over TLS by using the connection string")
+}
+
+func configTLS(uri string) {
+	// start-config-tls
+	caCert, err := os.ReadFile("path/to/ca.pem")
+	if err != nil {
+		panic(err)
+	}
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(caCert); !ok {
+		log.Fatal("Failed to parse the CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs: caPool,
+		// WARNING: InsecureSkipVerify disables server certificate and
+		// hostname validation, which exposes the connection to
+		// man-in-the-middle attacks. Use it only for local testing.
+		// InsecureSkipVerify: true,
+	}
+	clientOpts := options.Client().ApplyURI(uri).SetTLSConfig(tlsConfig)
+	// end-config-tls
+
+	client, err := mongo.Connect(context.TODO(), clientOpts)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(context.TODO())
+
+	if err := client.Ping(context.TODO(), readpref.Primary()); err != nil {
+		panic(err)
+	}
+	fmt.Println("Connected over TLS by using a custom CA certificate")
+}
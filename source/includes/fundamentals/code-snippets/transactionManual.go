@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	masalaID := result.InsertedIDs[0]
+	hibiscusID := result.InsertedIDs[5]
+
+	fmt.Println("\nManual Transaction:\n")
+	{
+		// begin manual transaction
+		// Unlike the WithTransaction() helper, this approach doesn't retry
+		// the operations or the commit after a transient error, so you
+		// must handle those errors yourself
+		session, err := client.StartSession()
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		err = mongo.WithSession(context.TODO(), session, func(sc mongo.SessionContext) error {
+			if err := session.StartTransaction(); err != nil {
+				return err
+			}
+
+			_, err := coll.UpdateOne(sc, bson.D{{"_id", masalaID}}, bson.D{{"$inc", bson.D{{"rating", -1}}}})
+			if err != nil {
+				session.AbortTransaction(sc)
+				return err
+			}
+			_, err = coll.UpdateOne(sc, bson.D{{"_id", hibiscusID}}, bson.D{{"$inc", bson.D{{"rating", 1}}}})
+			if err != nil {
+				session.AbortTransaction(sc)
+				return err
+			}
+
+			// Reads that use the session context see the uncommitted
+			// writes, while reads outside the transaction don't
+			var inTxn, outsideTxn Review
+			if err = coll.FindOne(sc, bson.D{{"_id", hibiscusID}}).Decode(&inTxn); err != nil {
+				session.AbortTransaction(sc)
+				return err
+			}
+			if err = coll.FindOne(context.TODO(), bson.D{{"_id", hibiscusID}}).Decode(&outsideTxn); err != nil {
+				session.AbortTransaction(sc)
+				return err
+			}
+			fmt.Printf("Hibiscus rating inside the transaction: %d\n", inTxn.Rating)
+			fmt.Printf("Hibiscus rating outside the transaction: %d\n", outsideTxn.Rating)
+
+			return session.CommitTransaction(sc)
+		})
+		if err != nil {
+			panic(err)
+		}
+		// end manual transaction
+	}
+
+	fmt.Println("\nRatings After Commit:\n")
+	{
+		filter := bson.D{{"_id", bson.D{{"$in", bson.A{masalaID, hibiscusID}}}}}
+
+		cursor, err := coll.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+	}
+}
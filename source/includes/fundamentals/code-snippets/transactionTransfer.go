@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(uri))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	// begin transfer callback
+	// transferRating returns a transaction callback that moves one rating
+	// point from one review to another
+	transferRating := func(fromID, toID interface{}) func(mongo.SessionContext) (interface{}, error) {
+		return func(ctx mongo.SessionContext) (interface{}, error) {
+			// Only decrement the rating if it stays above zero
+			fromFilter := bson.D{{"_id", fromID}, {"rating", bson.D{{"$gt", 1}}}}
+			res, err := coll.UpdateOne(ctx, fromFilter, bson.D{{"$inc", bson.D{{"rating", -1}}}})
+			if err != nil {
+				return nil, err
+			}
+			if res.ModifiedCount == 0 {
+				// Returning an error aborts the transaction
+				return nil, errors.New("source review has no rating points to transfer")
+			}
+
+			res, err = coll.UpdateOne(ctx, bson.D{{"_id", toID}}, bson.D{{"$inc", bson.D{{"rating", 1}}}})
+			if err != nil {
+				return nil, err
+			}
+			if res.MatchedCount == 0 {
+				return nil, errors.New("target review does not exist")
+			}
+			return nil, nil
+		}
+	}
+	// end transfer callback
+
+	masalaID := result.InsertedIDs[0]
+	hibiscusID := result.InsertedIDs[5]
+
+	fmt.Println("\nTransfer Rating Point:\n")
+	{
+		// begin with transaction
+		session, err := client.StartSession()
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		// WithTransaction commits the transaction if the callback returns
+		// nil. It retries the callback on transient transaction errors
+		// and retries the commit if its result is unknown.
+		_, err = session.WithTransaction(context.TODO(), transferRating(masalaID, hibiscusID))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println("Transaction committed")
+		// end with transaction
+	}
+
+	fmt.Println("\nAborted Transfer:\n")
+	{
+		// begin abort transaction
+		session, err := client.StartSession()
+		if err != nil {
+			panic(err)
+		}
+		defer session.EndSession(context.TODO())
+
+		var before Review
+		if err := coll.FindOne(context.TODO(), bson.D{{"_id", masalaID}}).Decode(&before); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Masala rating before the transfer: %d\n", before.Rating)
+
+		// No review has this _id, so the callback decrements the Masala
+		// rating and then fails on the increment. The error aborts the
+		// transaction, which rolls back the decrement.
+		missingID := primitive.NewObjectID()
+		_, err = session.WithTransaction(context.TODO(), transferRating(masalaID, missingID))
+		if err != nil {
+			fmt.Printf("Transaction aborted: %v\n", err)
+		}
+
+		var after Review
+		if err := coll.FindOne(context.TODO(), bson.D{{"_id", masalaID}}).Decode(&after); err != nil {
+			panic(err)
+		}
+		fmt.Printf("Masala rating after the aborted transfer: %d\n", after.Rating)
+		// end abort transaction
+	}
+
+	fmt.Println("\nFinal Ratings:\n")
+	{
+		filter := bson.D{{"_id", bson.D{{"$in", bson.A{masalaID, hibiscusID}}}}}
+
+		cursor, err := coll.Find(context.TODO(), filter)
+		if err != nil {
+			panic(err)
+		}
+
+		var results []Review
+		if err = cursor.All(context.TODO(), &results); err != nil {
+			panic(err)
+		}
+		for _, result := range results {
+			res, _ := json.Marshal(result)
+			fmt.Println(string(res))
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// start-review-struct
+type Review struct {
+	Item        string    `bson:"item,omitempty"`
+	Rating      int32     `bson:"rating,omitempty"`
+	DateOrdered time.Time `bson:"date_ordered,omitempty"`
+}
+
+// end-review-struct
+
+func main() {
+	var uri string
+	if uri = os.Getenv("MONGODB_URI"); uri == "" {
+		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://www.mongodb.com/docs/drivers/go/current/usage-examples/#environment-variable")
+	}
+
+	// begin client write concern
+	opts := options.Client().
+		ApplyURI(uri).
+		SetWriteConcern(writeconcern.Majority())
+
+	client, err := mongo.Connect(context.TODO(), opts)
+	// end client write concern
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = client.Disconnect(context.TODO()); err != nil {
+			panic(err)
+		}
+	}()
+
+	client.Database("tea").Collection("reviews").Drop(context.TODO())
+
+	// begin insert docs
+	coll := client.Database("tea").Collection("reviews")
+	docs := []interface{}{
+		Review{Item: "Masala", Rating: 10, DateOrdered: time.Date(2009, 11, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 7, DateOrdered: time.Date(2009, 11, 18, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 9, DateOrdered: time.Date(2009, 11, 12, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Masala", Rating: 8, DateOrdered: time.Date(2009, 12, 1, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Sencha", Rating: 10, DateOrdered: time.Date(2009, 12, 17, 0, 0, 0, 0, time.Local)},
+		Review{Item: "Hibiscus", Rating: 4, DateOrdered: time.Date(2009, 12, 18, 0, 0, 0, 0, time.Local)},
+	}
+
+	result, err := coll.InsertMany(context.TODO(), docs)
+	// end insert docs
+
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Number of documents inserted: %d\n", len(result.InsertedIDs))
+
+	fmt.Println("\nCollection Write Concern:\n")
+	{
+		// begin collection write concern
+		// The client-level majority write concern waits until most
+		// replica set members acknowledge each write. This collection
+		// overrides it to wait for exactly two members, and gives up
+		// waiting after five seconds.
+		wc := writeconcern.New(writeconcern.W(2), writeconcern.WTimeout(5*time.Second))
+		collOpts := options.Collection().SetWriteConcern(wc)
+		twoNodeColl := client.Database("tea").Collection("reviews", collOpts)
+
+		newReview := Review{Item: "Oolong", Rating: 7, DateOrdered: time.Date(2010, 1, 5, 0, 0, 0, 0, time.Local)}
+		result, err := twoNodeColl.InsertOne(context.TODO(), newReview)
+		// end collection write concern
+
+		// begin write concern error
+		// If two members can't acknowledge the write within the timeout,
+		// the write might still be applied on the primary, but the
+		// driver returns a write concern error
+		var we mongo.WriteException
+		if errors.As(err, &we) && we.WriteConcernError != nil {
+			wcErr := we.WriteConcernError
+			fmt.Printf("Write concern error %d: %s\n", wcErr.Code, wcErr.Message)
+			return
+		} else if err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("Inserted document with _id: %v\n", result.InsertedID)
+		// end write concern error
+	}
+}